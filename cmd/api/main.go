@@ -2,8 +2,9 @@ package main
 
 import (
 	"net/http"
+	_ "time/tzdata"
 
-	_ "github.com/kerhael/accounting/docs"
+	"github.com/kerhael/accounting/docs"
 
 	"github.com/kerhael/accounting/internal/auth"
 	"github.com/kerhael/accounting/internal/config"
@@ -13,6 +14,7 @@ import (
 	"github.com/kerhael/accounting/pkg/logger"
 	"github.com/kerhael/accounting/pkg/middleware"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"golang.org/x/time/rate"
 )
 
 // @title           Accounting API
@@ -47,22 +49,35 @@ func main() {
 	}
 	defer dbPool.Close()
 
-	// rate limiter
-	rateLimiter := middleware.NewRateLimiter(1, 5)
+	// rate limiters
+	authLimiter := middleware.NewRateLimiter(rate.Limit(cfg.AuthRateLimit.Rate), cfg.AuthRateLimit.Burst)
+	readLimiter := middleware.NewRateLimiter(rate.Limit(cfg.ReadRateLimit.Rate), cfg.ReadRateLimit.Burst)
+	exportLimiter := middleware.NewKeyedRateLimiter(rate.Limit(cfg.ExportRateLimit.Rate), cfg.ExportRateLimit.Burst, auth.UserIDKey)
+
+	// request deadlines
+	crudTimeout := middleware.NewTimeout(cfg.Timeouts.CRUD)
+	reportTimeout := middleware.NewTimeout(cfg.Timeouts.Reports)
+
+	// load shedding, health checks stay reachable under overload
+	loadShedder := middleware.NewLoadShedder(cfg.MaxConcurrentRequests, cfg.BasePath+"health", cfg.BasePath+"livez")
+
+	// cors
+	cors := middleware.NewCORS(cfg.CORS.AllowedOrigins, cfg.CORS.MaxAge)
 
 	// register handlers
-	handlers := handler.NewHandlers(dbPool, jwtService)
+	handlers := handler.NewHandlers(dbPool, jwtService, cfg)
 
 	// mux server
 	mux := http.NewServeMux()
 
 	// register routes
-	router.RegisterRoutes(mux, handlers, rateLimiter)
+	router.RegisterRoutes(mux, handlers, authLimiter, readLimiter, exportLimiter, crudTimeout, reportTimeout)
 
-	// swagger UI
+	// swagger UI, documenting the routes under the configured base path
+	docs.SwaggerInfo.BasePath = cfg.BasePath
 	mux.Handle("/swagger/", httpSwagger.WrapHandler)
 
-	if err := http.ListenAndServe(":8080", mux); err != http.ErrServerClosed {
+	if err := http.ListenAndServe(":8080", cors.CORSMiddleware(loadShedder.LoadSheddingMiddleware(mux))); err != http.ErrServerClosed {
 		logr.Error("server error:", err)
 	}
 }
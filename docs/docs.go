@@ -19,32 +19,26 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/categories/": {
+        "/admin/config": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all categories",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve the non-sensitive effective configuration, to debug deployments. Secrets are never returned. Restricted to the admin role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "admin"
                 ],
-                "summary": "Get all categories",
+                "summary": "Effective configuration",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/v1.CategoryResponse"
-                            }
+                            "$ref": "#/definitions/v1.ConfigResponse"
                         }
                     },
                     "401": {
@@ -53,21 +47,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/balance/pay-period": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new category",
+                "description": "Get the income minus the outcomes of the pay period containing now. Pay periods are lengthDays long and one of them starts on the anchor day, which suits pay cycles not matching calendar months (ex: biweekly).",
                 "consumes": [
                     "application/json"
                 ],
@@ -75,25 +71,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "reports"
                 ],
-                "summary": "Create a category",
+                "summary": "Get pay period balance",
                 "parameters": [
                     {
-                        "description": "Category payload",
-                        "name": "category",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.CreateCategoryRequest"
-                        }
+                        "type": "string",
+                        "description": "Start day of any pay period (YYYY-MM-DD format, in the server timezone)",
+                        "name": "anchor",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Pay period length in days (defaults to 14, max 365)",
+                        "name": "lengthDays",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.CategoryResponse"
+                            "$ref": "#/definitions/v1.PayPeriodBalanceResponse"
                         }
                     },
                     "400": {
@@ -108,6 +108,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -117,14 +123,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories/{id}": {
+        "/categories/": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a category by id",
+                "description": "Retrieve all categories, ordered by label or by total spend",
                 "consumes": [
                     "application/json"
                 ],
@@ -134,21 +140,47 @@ const docTemplate = `{
                 "tags": [
                     "categories"
                 ],
-                "summary": "Get a category",
+                "summary": "Get all categories",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Sort order: 'spend_desc' orders by total outcome amount descending (defaults to label)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date of the spend window when sorting by spend (ISO 8601 format, defaults to all time)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date of the spend window when sorting by spend (ISO 8601 format, defaults to all time)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated category fields to return (ex: id,label), defaults to all",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.CategoryResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CategoryResponse"
+                            }
                         }
                     },
                     "400": {
@@ -163,12 +195,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not found error",
-                        "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -177,13 +203,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a category by id",
+                "description": "Create a new category",
                 "consumes": [
                     "application/json"
                 ],
@@ -193,19 +219,24 @@ const docTemplate = `{
                 "tags": [
                     "categories"
                 ],
-                "summary": "Delete a category",
+                "summary": "Create a category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Category payload",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CategoryResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad request error",
@@ -219,82 +250,41 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "422": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "Check server and database connectivity",
-                "produces": [
-                    "text/plain"
-                ],
-                "tags": [
-                    "health"
-                ],
-                "summary": "Health check",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/incomes/": {
+        "/categories/archive-suggestions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all incomes with optional date filtering (defaults to current month if not provided)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the categories worth archiving: never used, or whose most recent outcome is older than staleMonths months, never used ones first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "incomes"
+                    "categories"
                 ],
-                "summary": "Get all incomes",
+                "summary": "Get archive suggestions",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date filter (ISO 8601 format, defaults to now)",
-                        "name": "to",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Items offset (defaults to 0)",
-                        "name": "offset",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
-                        "description": "Items limit (defaults to 20, max 100)",
-                        "name": "limit",
+                        "description": "Months without outcomes for a category to be stale (default 6)",
+                        "name": "staleMonths",
                         "in": "query"
                     }
                 ],
@@ -302,7 +292,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.PaginatedIncomesResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CategoryUsageResponse"
+                            }
                         }
                     },
                     "400": {
@@ -317,8 +310,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not found error",
+                    "422": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -330,50 +323,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/categories/ensure-defaults": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new income",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Create the configured starter categories if the user has no category yet. Idempotent: once the user has categories nothing is created and they are returned with a 200.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "incomes"
+                    "categories"
                 ],
-                "summary": "Create an income",
-                "parameters": [
-                    {
-                        "description": "Income payload",
-                        "name": "income",
-                        "in": "body",
-                        "required": true,
+                "summary": "Ensure default categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.CreateIncomeRequest"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CategoryResponse"
+                            }
                         }
-                    }
-                ],
-                "responses": {
+                    },
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.IncomeResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CategoryResponse"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad request error",
+                    "401": {
+                        "description": "Unauthorized error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized error",
+                    "422": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -387,14 +380,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/incomes/{id}": {
+        "/categories/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve an income by id",
+                "description": "Retrieve a category by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -402,23 +395,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "incomes"
+                    "categories"
                 ],
-                "summary": "Get an income",
+                "summary": "Get a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Income ID",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated category fields to return (ex: id,label), defaults to all",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.IncomeResponse"
+                            "$ref": "#/definitions/v1.CategoryResponse"
                         }
                     },
                     "400": {
@@ -439,6 +438,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -453,7 +458,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an income by id",
+                "description": "Delete a category by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -461,13 +466,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "incomes"
+                    "categories"
                 ],
-                "summary": "Delete an income",
+                "summary": "Delete a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Income ID",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -489,6 +494,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -503,7 +514,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an income",
+                "description": "Update the label and/or color of a category, an empty color clearing it",
                 "consumes": [
                     "application/json"
                 ],
@@ -511,24 +522,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "incomes"
+                    "categories"
                 ],
-                "summary": "Update an income",
+                "summary": "Update a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Income ID",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Income payload",
-                        "name": "income",
+                        "description": "Category payload",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.PatchIncomeByIdRequest"
+                            "$ref": "#/definitions/v1.PatchCategoryByIdRequest"
                         }
                     }
                 ],
@@ -536,7 +547,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.IncomeResponse"
+                            "$ref": "#/definitions/v1.CategoryResponse"
                         }
                     },
                     "400": {
@@ -557,23 +568,144 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "422": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
-                    }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "/outcomes/": {
+        "/categories/{id}/clone": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new category with the settings (color) of an existing one, without its outcomes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Clone a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clone payload",
+                        "name": "category",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CloneCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Check server and database connectivity. When HEALTH_SECRET is configured, the X-Health-Secret header must hold it.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Health check shared secret, when configured",
+                        "name": "X-Health-Secret",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/incomes/": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all outcomes with optional category and date filtering (defaults to current month if not provided)",
+                "description": "Retrieve all incomes with optional date filtering (defaults to current month if not provided)",
                 "consumes": [
                     "application/json"
                 ],
@@ -581,9 +713,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "outcomes"
+                    "incomes"
                 ],
-                "summary": "Get all outcomes",
+                "summary": "Get all incomes",
                 "parameters": [
                     {
                         "type": "string",
@@ -598,9 +730,9 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Category ID filter",
-                        "name": "categoryId",
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
                         "in": "query"
                     },
                     {
@@ -614,13 +746,19 @@ const docTemplate = `{
                         "description": "Items limit (defaults to 20, max 100)",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated income fields to return (ex: id,amount), defaults to all",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.PaginatedOutcomesResponse"
+                            "$ref": "#/definitions/v1.PaginatedIncomesResponse"
                         }
                     },
                     "400": {
@@ -655,7 +793,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new outcome",
+                "description": "Create a new income",
                 "consumes": [
                     "application/json"
                 ],
@@ -663,17 +801,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "outcomes"
+                    "incomes"
                 ],
-                "summary": "Create an outcome",
+                "summary": "Create an income",
                 "parameters": [
                     {
-                        "description": "Outcome payload",
-                        "name": "outcome",
+                        "description": "Income payload",
+                        "name": "income",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.CreateOutcomeRequest"
+                            "$ref": "#/definitions/v1.CreateIncomeRequest"
                         }
                     }
                 ],
@@ -681,7 +819,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.OutcomeResponse"
+                            "$ref": "#/definitions/v1.IncomeResponse"
                         }
                     },
                     "400": {
@@ -696,6 +834,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -705,14 +849,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/outcomes/series-by-category": {
+        "/incomes/date-bounds": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the sum of outcomes by category for each month between dates (defaults to last 12 months if not provided)",
+                "description": "Get the dates of the earliest and latest incomes, or no content if there are none",
                 "consumes": [
                     "application/json"
                 ],
@@ -720,41 +864,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "outcomes"
-                ],
-                "summary": "Get monthly series of outcomes",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Start date filter (ISO 8601 format, defaults to 12 months ago)",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date filter (ISO 8601 format, defaults to now)",
-                        "name": "to",
-                        "in": "query"
-                    }
+                    "incomes"
                 ],
+                "summary": "Get date bounds of incomes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/v1.MonthlySeries"
-                                }
-                            }
+                            "$ref": "#/definitions/v1.DateBoundsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request error",
-                        "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "401": {
                         "description": "Unauthorized error",
@@ -771,14 +892,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/outcomes/series-total": {
+        "/incomes/largest": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the total sum of outcomes for each month between dates (defaults to last 12 months if not provided)",
+                "description": "Retrieve the largest incomes between dates (defaults to current month if not provided)",
                 "consumes": [
                     "application/json"
                 ],
@@ -786,13 +907,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "outcomes"
+                    "incomes"
                 ],
-                "summary": "Get monthly series of outcomes' total amount",
+                "summary": "Get largest incomes",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date filter (ISO 8601 format, defaults to 12 months ago)",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
                         "name": "from",
                         "in": "query"
                     },
@@ -801,6 +922,24 @@ const docTemplate = `{
                         "description": "End date filter (ISO 8601 format, defaults to now)",
                         "name": "to",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items limit (defaults to 5, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aggregate over all time without date defaulting (cannot be combined with date filters)",
+                        "name": "allTime",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -809,10 +948,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/v1.MonthlyTotalSeries"
-                                }
+                                "$ref": "#/definitions/v1.IncomeResponse"
                             }
                         }
                     },
@@ -837,14 +973,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/outcomes/sums-by-category": {
+        "/incomes/stats": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the total amount of outcomes by category between dates (defaults to current month if not provided), optionally filtered by category",
+                "description": "Get the count, min, max, average and median amount of incomes between dates (defaults to current month if not provided)",
                 "consumes": [
                     "application/json"
                 ],
@@ -852,9 +988,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "outcomes"
+                    "incomes"
                 ],
-                "summary": "Get sum of outcomes by category",
+                "summary": "Get incomes statistics",
                 "parameters": [
                     {
                         "type": "string",
@@ -869,9 +1005,15 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Category ID filter",
-                        "name": "categoryId",
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aggregate over all time without date defaulting (cannot be combined with date filters)",
+                        "name": "allTime",
                         "in": "query"
                     }
                 ],
@@ -879,10 +1021,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/v1.CategorySumResponse"
-                            }
+                            "$ref": "#/definitions/v1.IncomeStatsResponse"
                         }
                     },
                     "400": {
@@ -897,12 +1036,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not found error",
-                        "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -912,14 +1045,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/outcomes/total": {
+        "/incomes/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the total amount of outcomes between dates (defaults to current month if not provided)",
+                "description": "Retrieve an income by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -927,20 +1060,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "outcomes"
+                    "incomes"
                 ],
-                "summary": "Get total of outcomes",
+                "summary": "Get an income",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
-                        "name": "from",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "Income ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "End date filter (ISO 8601 format, defaults to now)",
-                        "name": "to",
+                        "description": "Comma separated income fields to return (ex: id,amount), defaults to all",
+                        "name": "fields",
                         "in": "query"
                     }
                 ],
@@ -948,7 +1082,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.TotalOutcomeResponse"
+                            "$ref": "#/definitions/v1.IncomeResponse"
                         }
                     },
                     "400": {
@@ -963,6 +1097,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -970,16 +1116,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/outcomes/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve an outcome by id",
+                "description": "Delete an income by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -987,24 +1131,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "outcomes"
+                    "incomes"
                 ],
-                "summary": "Get an outcome",
+                "summary": "Delete an income",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Outcome ID",
+                        "description": "Income ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/v1.OutcomeResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad request error",
@@ -1024,6 +1165,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1032,13 +1179,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an outcome by id",
+                "description": "Update an income",
                 "consumes": [
                     "application/json"
                 ],
@@ -1046,21 +1193,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "outcomes"
+                    "incomes"
                 ],
-                "summary": "Delete an outcome",
+                "summary": "Update an income",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Outcome ID",
+                        "description": "Income ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Income payload",
+                        "name": "income",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PatchIncomeByIdRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.IncomeResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad request error",
@@ -1074,6 +1233,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1081,14 +1252,36 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/livez": {
+            "get": {
+                "description": "Check the server is up, without checking dependencies nor requiring any secret",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an outcome",
+                "description": "Retrieve all outcomes with optional category and date filtering (defaults to current month if not provided)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1098,30 +1291,68 @@ const docTemplate = `{
                 "tags": [
                     "outcomes"
                 ],
-                "summary": "Update an outcome",
+                "summary": "Get all outcomes",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Outcome ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Category ID filter (must be positive when provided)",
+                        "name": "categoryId",
+                        "in": "query"
                     },
                     {
-                        "description": "Outcome payload",
-                        "name": "outcome",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.PatchOutcomeByIdRequest"
-                        }
+                        "type": "integer",
+                        "description": "Items offset (defaults to 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items limit (defaults to 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the previous page (\u003ccreatedAt\u003e,\u003cid\u003e, as returned in nextCursor), cannot be combined with offset",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Serialize outcome amounts as negative numbers (defaults to false)",
+                        "name": "signed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated outcome fields to return (ex: id,amount), defaults to all",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.OutcomeResponse"
+                            "$ref": "#/definitions/v1.PaginatedOutcomesResponse"
                         }
                     },
                     "400": {
@@ -1142,6 +1373,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1149,11 +1386,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/refresh": {
+            },
             "post": {
-                "description": "Generate a new access token and refresh token from a valid refresh token.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new outcome",
                 "consumes": [
                     "application/json"
                 ],
@@ -1161,25 +1401,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "outcomes"
                 ],
-                "summary": "Refresh JWT tokens",
+                "summary": "Create an outcome",
                 "parameters": [
                     {
-                        "description": "Refresh token payload",
-                        "name": "refresh",
+                        "description": "Outcome payload",
+                        "name": "outcome",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.RefreshTokenRequest"
+                            "$ref": "#/definitions/v1.CreateOutcomeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.RefreshTokenResponse"
+                            "$ref": "#/definitions/v1.OutcomeResponse"
                         }
                     },
                     "400": {
@@ -1194,6 +1434,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1203,9 +1449,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/": {
-            "post": {
-                "description": "Create a new user. A rate limiter prevents from brute force attacks (speed 1s, burst 5)",
+        "/outcomes/avg-by-category": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the average amount and the number of outcomes by category between dates (defaults to current month if not provided)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1213,25 +1464,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "outcomes"
                 ],
-                "summary": "Create a user",
+                "summary": "Get average outcome amount by category",
                 "parameters": [
                     {
-                        "description": "User payload",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.CreateUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aggregate over all time without date defaulting (cannot be combined with date filters)",
+                        "name": "allTime",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.UserResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CategoryAverageResponse"
+                            }
                         }
                     },
                     "400": {
@@ -1240,8 +1509,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "429": {
-                        "description": "Too many requests error",
+                    "401": {
+                        "description": "Unauthorized error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -1255,9 +1524,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/login": {
-            "post": {
-                "description": "User login. A rate limiter prevents from brute force attacks (speed 1s, burst 5)",
+        "/outcomes/bulk": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update several outcomes at once in a single transaction. If any item fails, nothing is updated and the failures are listed by index. Arrays longer than MAX_BULK_ITEMS are rejected",
                 "consumes": [
                     "application/json"
                 ],
@@ -1265,17 +1539,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "outcomes"
                 ],
-                "summary": "Login",
+                "summary": "Update several outcomes",
                 "parameters": [
                     {
-                        "description": "Login payload",
-                        "name": "login",
+                        "description": "Outcome patches",
+                        "name": "outcomes",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.LoginRequest"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.BulkPatchOutcomeRequest"
+                            }
                         }
                     }
                 ],
@@ -1283,7 +1560,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.LoginResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.OutcomeResponse"
+                            }
                         }
                     },
                     "400": {
@@ -1298,10 +1578,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "429": {
-                        "description": "Too many requests error",
+                    "422": {
+                        "description": "Validation error",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponse"
+                            "$ref": "#/definitions/v1.BulkErrorResponse"
                         }
                     },
                     "500": {
@@ -1313,14 +1593,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
+        "/outcomes/by-hour": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve the authenticated user.",
+                "description": "Get the total amount of outcomes for each hour of the day (0-23) between dates (defaults to current month if not provided). Hours without outcomes are returned with a zero total.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1328,20 +1608,2008 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "outcomes"
                 ],
-                "summary": "Retrieve the authenticated user",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/v1.UserResponse"
-                        }
+                "summary": "Get outcomes by hour of day",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone used to bucket hours (ex: Europe/Paris, defaults to the server timezone)",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aggregate over all time without date defaulting (cannot be combined with date filters)",
+                        "name": "allTime",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.HourlySumResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/date-bounds": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the dates of the earliest and latest outcomes, or no content if there are none",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get date bounds of outcomes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DateBoundsResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/export.xlsx": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the outcomes as an XLSX workbook with a header row and formatted dates and amounts, honoring the list filters. If no dates are provided, the current month is exported. Exports of more than 50000 outcomes are rejected with a 400.",
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Export outcomes as an Excel file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (ISO 8601)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (ISO 8601)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM, in the configured timezone), cannot be combined with from/to",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "categoryId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/forecast": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Forecast the outcomes of the next months, each category being predicted at its average over the last 6 complete months (months without outcomes counting as zero)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get outcomes forecast",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of months to forecast (defaults to 3, max 12)",
+                        "name": "months",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.MonthlyForecast"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/frequent": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the outcome names logged the most often between dates (defaults to current month if not provided), such as usual merchants, with their count and total, by descending count",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get most frequent outcome names",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items limit (defaults to 10, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.FrequentNameResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/grouped": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the total and number of outcomes of each day, week, month, quarter or year between dates (defaults to the last 12 months if not provided), periods without outcomes included. Periods are taken in the server timezone, weeks starting on Monday. The range may span at most 400 periods.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get outcomes grouped by interval",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Period length: day, week, month, quarter or year (defaults to month)",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of the month 11 months ago)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.PeriodSumResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/histogram": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the number and total of outcomes in each amount bucket between dates (defaults to current month if not provided). Buckets are given by their ascending lower bounds in cents, each one ending where the next starts and the last one being unbounded. Outcomes under the first bound are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get histogram of outcome amounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated lower bounds of the buckets in cents (defaults to 0,1000,5000,10000,50000)",
+                        "name": "buckets",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.AmountBucketResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/monthly-counts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the number of outcomes of each month between dates (defaults to last 12 months if not provided), months without outcomes counting zero. Suited to activity sparklines.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get monthly counts of outcomes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to 12 months ago)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/v1.MonthlyCount"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/new-categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the categories with outcomes during the given month but none during the previous month (defaults to current month if not provided)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get new categories of outcomes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM format, defaults to current month)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CategorySumResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/outliers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the outcomes whose amount exceeds ` + "`" + `factor` + "`" + ` times their category's average between dates (defaults to current month if not provided)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get outlier outcomes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Multiple of the category average an outcome must exceed (defaults to 2, at least 1)",
+                        "name": "factor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.OutlierOutcomeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/projection": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Project the current month's total outcomes linearly from the spending so far",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get projected month-end outcomes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProjectionOutcomeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/rolling": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the total amount of outcomes over a trailing window ending now, independently of calendar months",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get rolling total of outcomes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trailing window in days (ex: 30d, defaults to 30d, max 365d)",
+                        "name": "window",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RollingOutcomeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/series-by-category": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the sum of outcomes by category for each month between dates (defaults to last 12 months if not provided)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get monthly series of outcomes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to 12 months ago)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Serialize outcome amounts as negative numbers (defaults to false)",
+                        "name": "signed",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/v1.MonthlySeries"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/series-total": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the total sum of outcomes for each month between dates (defaults to last 12 months if not provided)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get monthly series of outcomes' total amount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to 12 months ago)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Serialize outcome amounts as negative numbers (defaults to false)",
+                        "name": "signed",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/v1.MonthlyTotalSeries"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/streaks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the longest run of consecutive days with at least one outcome and the longest run of days without any, between dates (defaults to current month if not provided). Days are taken in the server timezone. The range may span at most 3660 days.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get spending streaks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.StreaksOutcomeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/sums-by-category": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the total amount of outcomes by category between dates (defaults to current month if not provided), optionally filtered by category. Categories without outcomes in the period are returned with a zero total.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get sum of outcomes by category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Category ID filter (must be positive when provided)",
+                        "name": "categoryId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aggregate over all time without date defaulting (cannot be combined with date filters)",
+                        "name": "allTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return the number of outcomes of each category (defaults to false)",
+                        "name": "withCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Serialize outcome amounts as negative numbers (defaults to false)",
+                        "name": "signed",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CategorySumResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/total": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the total amount of outcomes between dates (defaults to current month if not provided)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get total of outcomes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to first day of current month)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aggregate over all time without date defaulting (cannot be combined with date filters)",
+                        "name": "allTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Serialize outcome amounts as negative numbers (defaults to false)",
+                        "name": "signed",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TotalOutcomeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/year-over-year": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compare the total of outcomes of a month with the same month a year earlier (defaults to current month if not provided)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get outcomes year over year",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM format, defaults to current month)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.YearOverYearOutcomeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outcomes/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an outcome by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Get an outcome",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Outcome ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Serialize outcome amounts as negative numbers (defaults to false)",
+                        "name": "signed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated outcome fields to return (ex: id,amount), defaults to all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.OutcomeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an outcome by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Delete an outcome",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Outcome ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an outcome",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "outcomes"
+                ],
+                "summary": "Update an outcome",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Outcome ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Outcome payload",
+                        "name": "outcome",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PatchOutcomeByIdRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.OutcomeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh": {
+            "post": {
+                "description": "Generate a new access token and refresh token from a valid refresh token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh JWT tokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token payload",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RefreshTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/monthly.pdf": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download a PDF statement of a month, with its income total, outcome total, net and the spend of each category.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Export a monthly statement as a PDF file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM format, in the server timezone, defaults to current month)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/savings-rate": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the income, the outcomes and the savings rate ((income - outcome) / income, as a percentage) of each month between dates (defaults to last 12 months if not provided). The rate is null for months without income.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get monthly savings rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date filter (ISO 8601 format, defaults to 12 months ago)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date filter (ISO 8601 format, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.MonthlySavingsRateResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/spend-vs-income": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get each category's spend of a month as a percentage of that month's income, along with the overall savings rate. Percentages are null when there is no income.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get spend versus income report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM format, in the server timezone, defaults to current month)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SpendVsIncomeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/time": {
+            "get": {
+                "description": "Retrieve the current database time and the configured timezone, to detect client clock skew",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "time"
+                ],
+                "summary": "Server time",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TimeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Search incomes and outcomes whose name contains the given term (case-insensitive), most recent first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Search transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search term",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items offset (defaults to 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items limit (defaults to 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Serialize outcome amounts as negative numbers (defaults to false)",
+                        "name": "signed",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PaginatedTransactionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/": {
+            "post": {
+                "description": "Create a new user. A rate limiter prevents from brute force attacks (speed 1s, burst 5)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a user",
+                "parameters": [
+                    {
+                        "description": "User payload",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "User login. A rate limiter prevents from brute force attacks (speed 1s, burst 5)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Login payload",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Retrieve the authenticated user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad request error",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponse"
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/onboarding": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve whether the authenticated user already has categories, incomes and outcomes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get onboarding status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.OnboardingResponse"
                         }
                     },
                     "401": {
@@ -1350,12 +3618,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found error",
-                        "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1408,6 +3670,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1476,6 +3744,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1487,9 +3761,121 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "v1.AmountBucketResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of outcomes in the bucket",
+                    "type": "integer"
+                },
+                "max": {
+                    "description": "Upper bound in cents, excluded (null for the last bucket)",
+                    "type": "integer"
+                },
+                "min": {
+                    "description": "Lower bound in cents, included",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Total amount in cents of the outcomes in the bucket",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.BulkErrorResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ItemErrorResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BulkPatchOutcomeRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Amount in cents (optional, ex: 1999 for 19.99€)",
+                    "type": "integer"
+                },
+                "categoryId": {
+                    "description": "ID of the associated category (optional)",
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "description": "Date of the expense (optional, ex: \"2026-01-01T00:00:00Z\", in the server timezone without offset)",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the expense to update",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name of the expense (optional)",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CORSConfigResponse": {
+            "type": "object",
+            "properties": {
+                "allowedOrigins": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxAge": {
+                    "description": "Preflight cache duration in seconds",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.CategoryAverageResponse": {
+            "type": "object",
+            "properties": {
+                "avg": {
+                    "description": "Average outcome amount in cents for this category",
+                    "type": "integer"
+                },
+                "categoryId": {
+                    "description": "Category ID",
+                    "type": "integer"
+                },
+                "count": {
+                    "description": "Number of outcomes for this category",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.CategoryIncomeShareResponse": {
+            "type": "object",
+            "properties": {
+                "categoryId": {
+                    "description": "Category ID",
+                    "type": "integer"
+                },
+                "percentOfIncome": {
+                    "description": "Category spend as a percentage of income (null when there is no income)",
+                    "type": "number"
+                },
+                "total": {
+                    "description": "Total amount in cents spent in this category",
+                    "type": "integer"
+                }
+            }
+        },
         "v1.CategoryResponse": {
             "type": "object",
             "properties": {
+                "color": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -1505,15 +3891,106 @@ const docTemplate = `{
                     "description": "Category ID",
                     "type": "integer"
                 },
+                "count": {
+                    "description": "Number of outcomes for this category, only with withCount=true",
+                    "type": "integer"
+                },
                 "total": {
                     "description": "Total amount in cents for this category",
                     "type": "integer"
                 }
             }
         },
+        "v1.CategoryUsageResponse": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "lastUsedAt": {
+                    "description": "Date of the most recent outcome, null if never used",
+                    "type": "string"
+                },
+                "outcomeCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.CloneCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "description": "Label of the new category (optional, defaults to the source label suffixed with \" (copy)\")",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ConfigResponse": {
+            "type": "object",
+            "properties": {
+                "authRateLimit": {
+                    "description": "Login, token refresh and signup",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.RateLimitConfigResponse"
+                        }
+                    ]
+                },
+                "basePath": {
+                    "description": "BasePath prefixes the API routes (ex: \"/api/v1/\")",
+                    "type": "string"
+                },
+                "cors": {
+                    "$ref": "#/definitions/v1.CORSConfigResponse"
+                },
+                "database": {
+                    "$ref": "#/definitions/v1.DatabaseConfigResponse"
+                },
+                "exportRateLimit": {
+                    "description": "Export routes, per user",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.RateLimitConfigResponse"
+                        }
+                    ]
+                },
+                "maxConcurrentRequests": {
+                    "description": "MaxConcurrentRequests caps the requests served at once, 0 when unlimited",
+                    "type": "integer"
+                },
+                "readRateLimit": {
+                    "description": "Authenticated GET requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.RateLimitConfigResponse"
+                        }
+                    ]
+                },
+                "timeouts": {
+                    "$ref": "#/definitions/v1.TimeoutConfigResponse"
+                },
+                "timezone": {
+                    "description": "Configured timezone (ex: \"Europe/Paris\")",
+                    "type": "string"
+                },
+                "validation": {
+                    "$ref": "#/definitions/v1.ValidationConfigResponse"
+                }
+            }
+        },
         "v1.CreateCategoryRequest": {
             "type": "object",
             "properties": {
+                "color": {
+                    "description": "Hex color used in charts (optional, ex: \"#FF8800\")",
+                    "type": "string"
+                },
                 "label": {
                     "type": "string"
                 }
@@ -1527,7 +4004,7 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "createdAt": {
-                    "description": "Date of the income (ex: \"2026-01-01T00:00:00Z\")",
+                    "description": "Date of the income (ex: \"2026-01-01T00:00:00Z\", in the server timezone without offset)",
                     "type": "string"
                 },
                 "name": {
@@ -1544,11 +4021,11 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "categoryId": {
-                    "description": "ID of the associated category",
+                    "description": "ID of the associated category (defaults to the user's default category)",
                     "type": "integer"
                 },
                 "createdAt": {
-                    "description": "Date of the expense (ex: \"2026-01-01T00:00:00Z\")",
+                    "description": "Date of the expense (ex: \"2026-01-01T00:00:00Z\", in the server timezone without offset)",
                     "type": "string"
                 },
                 "name": {
@@ -1578,6 +4055,36 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.DatabaseConfigResponse": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "string"
+                },
+                "sslMode": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DateBoundsResponse": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "description": "Date of the latest transaction",
+                    "type": "string"
+                },
+                "min": {
+                    "description": "Date of the earliest transaction",
+                    "type": "string"
+                }
+            }
+        },
         "v1.ErrorResponse": {
             "type": "object",
             "properties": {
@@ -1586,6 +4093,35 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.FrequentNameResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of outcomes with this name",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total amount in cents of these outcomes",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.HourlySumResponse": {
+            "type": "object",
+            "properties": {
+                "hour": {
+                    "description": "Hour of the day (0-23)",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Total amount in cents spent during this hour",
+                    "type": "integer"
+                }
+            }
+        },
         "v1.IncomeResponse": {
             "type": "object",
             "properties": {
@@ -1601,33 +4137,125 @@ const docTemplate = `{
                     "description": "ID of the income",
                     "type": "integer"
                 },
-                "name": {
-                    "description": "Name of the income",
+                "name": {
+                    "description": "Name of the income",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.IncomeStatsResponse": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "description": "Average amount in cents (rounded)",
+                    "type": "integer"
+                },
+                "count": {
+                    "description": "Number of incomes in the window",
+                    "type": "integer"
+                },
+                "max": {
+                    "description": "Largest amount in cents",
+                    "type": "integer"
+                },
+                "median": {
+                    "description": "Median amount in cents (rounded)",
+                    "type": "integer"
+                },
+                "min": {
+                    "description": "Smallest amount in cents",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ItemErrorResponse": {
+            "type": "object",
+            "properties": {
+                "index": {
+                    "description": "Index of the failed item in the request",
+                    "type": "integer"
+                },
+                "message": {
+                    "description": "Reason of the failure",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "description": "refresh token",
+                    "type": "string"
+                },
+                "token": {
+                    "description": "bearer token",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.MonthlyCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of outcomes",
+                    "type": "integer"
+                },
+                "month": {
+                    "description": "Month in YYYY-MM format",
                     "type": "string"
                 }
             }
         },
-        "v1.LoginRequest": {
+        "v1.MonthlyForecast": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "categories": {
+                    "description": "Map of categoryId to forecast amount in cents",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
                 },
-                "password": {
+                "month": {
+                    "description": "Forecast month in YYYY-MM format",
                     "type": "string"
+                },
+                "total": {
+                    "description": "Forecast total amount in cents",
+                    "type": "integer"
                 }
             }
         },
-        "v1.LoginResponse": {
+        "v1.MonthlySavingsRateResponse": {
             "type": "object",
             "properties": {
-                "refresh_token": {
-                    "description": "refresh token",
-                    "type": "string"
+                "income": {
+                    "description": "Total income in cents",
+                    "type": "integer"
                 },
-                "token": {
-                    "description": "bearer token",
+                "month": {
+                    "description": "Month in YYYY-MM format",
                     "type": "string"
+                },
+                "outcome": {
+                    "description": "Total outcomes in cents",
+                    "type": "integer"
+                },
+                "savingsRate": {
+                    "description": "Percentage of income left after spending (null when there is no income)",
+                    "type": "number"
                 }
             }
         },
@@ -1660,6 +4288,23 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.OnboardingResponse": {
+            "type": "object",
+            "properties": {
+                "hasCategories": {
+                    "description": "Whether the user created at least one category",
+                    "type": "boolean"
+                },
+                "hasIncome": {
+                    "description": "Whether the user recorded at least one income",
+                    "type": "boolean"
+                },
+                "hasOutcome": {
+                    "description": "Whether the user recorded at least one outcome",
+                    "type": "boolean"
+                }
+            }
+        },
         "v1.OutcomeResponse": {
             "type": "object",
             "properties": {
@@ -1685,6 +4330,35 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.OutlierOutcomeResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Amount in cents (ex: 1999 for 19.99€)",
+                    "type": "integer"
+                },
+                "categoryAvg": {
+                    "description": "Average outcome amount in cents of the category over the window",
+                    "type": "integer"
+                },
+                "categoryId": {
+                    "description": "ID of the associated category",
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "description": "Date of the expense (ex: \"2026-01-01T00:00:00Z\")",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the expense",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name of the expense",
+                    "type": "string"
+                }
+            }
+        },
         "v1.PaginatedIncomesResponse": {
             "type": "object",
             "properties": {
@@ -1708,6 +4382,24 @@ const docTemplate = `{
                         "$ref": "#/definitions/v1.OutcomeResponse"
                     }
                 },
+                "nextCursor": {
+                    "description": "Value of the after parameter fetching the next page (omitted on the last page)",
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/v1.PaginationResponse"
+                }
+            }
+        },
+        "v1.PaginatedTransactionsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TransactionResponse"
+                    }
+                },
                 "pagination": {
                     "$ref": "#/definitions/v1.PaginationResponse"
                 }
@@ -1727,6 +4419,19 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.PatchCategoryByIdRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "Hex color used in charts (optional, ex: \"#FF8800\", \"\" clears it)",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Label of the category (optional)",
+                    "type": "string"
+                }
+            }
+        },
         "v1.PatchIncomeByIdRequest": {
             "type": "object",
             "properties": {
@@ -1735,7 +4440,7 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "createdAt": {
-                    "description": "Date of the income (optional, ex: \"2026-01-01T00:00:00Z\")",
+                    "description": "Date of the income (optional, ex: \"2026-01-01T00:00:00Z\", in the server timezone without offset)",
                     "type": "string"
                 },
                 "name": {
@@ -1756,7 +4461,7 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "createdAt": {
-                    "description": "Date of the expense (optional, ex: \"2026-01-01T00:00:00Z\")",
+                    "description": "Date of the expense (optional, ex: \"2026-01-01T00:00:00Z\", in the server timezone without offset)",
                     "type": "string"
                 },
                 "name": {
@@ -1768,6 +4473,10 @@ const docTemplate = `{
         "v1.PatchUserByIdRequest": {
             "type": "object",
             "properties": {
+                "defaultCategoryId": {
+                    "description": "Default category for quick-entry outcomes, 0 clears it (optional)",
+                    "type": "integer"
+                },
                 "firstName": {
                     "description": "User first name (optional)",
                     "type": "string"
@@ -1779,6 +4488,85 @@ const docTemplate = `{
                 "password": {
                     "description": "User password (optional)",
                     "type": "string"
+                },
+                "requireCategory": {
+                    "description": "Reject outcomes without a category, otherwise they may be left uncategorized (optional)",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.PayPeriodBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "Start of the pay period",
+                    "type": "string"
+                },
+                "income": {
+                    "description": "Total income in cents over the pay period",
+                    "type": "integer"
+                },
+                "net": {
+                    "description": "Income minus spend in cents",
+                    "type": "integer"
+                },
+                "spent": {
+                    "description": "Total spend in cents over the pay period",
+                    "type": "integer"
+                },
+                "to": {
+                    "description": "End of the pay period",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PeriodSumResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of outcomes during this period",
+                    "type": "integer"
+                },
+                "start": {
+                    "description": "First day of the period (YYYY-MM-DD)",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total amount in cents spent during this period",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ProjectionOutcomeResponse": {
+            "type": "object",
+            "properties": {
+                "daysElapsed": {
+                    "description": "Number of days elapsed in the month, today included",
+                    "type": "integer"
+                },
+                "daysInMonth": {
+                    "description": "Number of days in the month",
+                    "type": "integer"
+                },
+                "projectedTotal": {
+                    "description": "Projected month-end total in cents",
+                    "type": "integer"
+                },
+                "spentSoFar": {
+                    "description": "Amount in cents spent since the start of the month",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.RateLimitConfigResponse": {
+            "type": "object",
+            "properties": {
+                "burst": {
+                    "type": "integer"
+                },
+                "rate": {
+                    "description": "Requests per second",
+                    "type": "number"
                 }
             }
         },
@@ -1803,6 +4591,119 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.RollingOutcomeResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "Start of the window",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "End of the window (now)",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total amount in cents over the window",
+                    "type": "integer"
+                },
+                "window": {
+                    "description": "Trailing window (ex: \"30d\")",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SpendVsIncomeResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "description": "Spend of each category",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CategoryIncomeShareResponse"
+                    }
+                },
+                "income": {
+                    "description": "Total income in cents",
+                    "type": "integer"
+                },
+                "month": {
+                    "description": "Month in YYYY-MM format",
+                    "type": "string"
+                },
+                "savingsRate": {
+                    "description": "Percentage of income left after spending (null when there is no income)",
+                    "type": "number"
+                },
+                "spent": {
+                    "description": "Total spend in cents",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.StreakResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "description": "Length of the streak in days",
+                    "type": "integer"
+                },
+                "from": {
+                    "description": "First day of the streak (YYYY-MM-DD, omitted when empty)",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "Last day of the streak (YYYY-MM-DD, omitted when empty)",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.StreaksOutcomeResponse": {
+            "type": "object",
+            "properties": {
+                "longestNoSpend": {
+                    "description": "Longest run of days without any outcome",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.StreakResponse"
+                        }
+                    ]
+                },
+                "longestSpending": {
+                    "description": "Longest run of days with at least one outcome",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.StreakResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.TimeResponse": {
+            "type": "object",
+            "properties": {
+                "now": {
+                    "description": "Current database time in RFC3339 format (ex: \"2026-01-01T10:00:00+01:00\")",
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Configured timezone (ex: \"Europe/Paris\")",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TimeoutConfigResponse": {
+            "type": "object",
+            "properties": {
+                "crud": {
+                    "description": "CRUD request deadline in seconds",
+                    "type": "integer"
+                },
+                "reports": {
+                    "description": "Report request deadline in seconds",
+                    "type": "integer"
+                }
+            }
+        },
         "v1.TotalOutcomeResponse": {
             "type": "object",
             "properties": {
@@ -1812,9 +4713,46 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.TransactionResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Amount in cents (ex: 1999 for 19.99€)",
+                    "type": "integer"
+                },
+                "categoryId": {
+                    "description": "ID of the associated category (outcomes only)",
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "description": "Date of the transaction (ex: \"2026-01-01T00:00:00Z\")",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the income or outcome",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name of the transaction",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Transaction type (\"income\" or \"outcome\")",
+                    "type": "string"
+                }
+            }
+        },
         "v1.UserResponse": {
             "type": "object",
             "properties": {
+                "createdAt": {
+                    "description": "Account creation date (ex: \"2026-01-01T00:00:00Z\")",
+                    "type": "string"
+                },
+                "defaultCategoryId": {
+                    "description": "Category used for outcomes posted without one (omitted when unset)",
+                    "type": "integer"
+                },
                 "email": {
                     "description": "User email",
                     "type": "string"
@@ -1830,6 +4768,64 @@ const docTemplate = `{
                 "lastName": {
                     "description": "User last name",
                     "type": "string"
+                },
+                "requireCategory": {
+                    "description": "Whether outcomes must have a category",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.ValidationConfigResponse": {
+            "type": "object",
+            "properties": {
+                "clampFutureTo": {
+                    "type": "boolean"
+                },
+                "freezeDate": {
+                    "description": "YYYY-MM-DD, records created before it are read-only",
+                    "type": "string"
+                },
+                "maxBulkItems": {
+                    "description": "Items accepted by a bulk request",
+                    "type": "integer"
+                },
+                "maxNameLength": {
+                    "type": "integer"
+                },
+                "normalizeCategoryLabels": {
+                    "type": "boolean"
+                },
+                "rejectNumericNames": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.YearOverYearOutcomeResponse": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "description": "Total minus previous total, in cents",
+                    "type": "integer"
+                },
+                "month": {
+                    "description": "Compared month (ex: \"2026-03\")",
+                    "type": "string"
+                },
+                "percent": {
+                    "description": "Delta as a percentage of the previous total, null without previous spending",
+                    "type": "number"
+                },
+                "previousMonth": {
+                    "description": "Same month a year earlier (ex: \"2025-03\")",
+                    "type": "string"
+                },
+                "previousTotal": {
+                    "description": "Total amount in cents of the previous year's month",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Total amount in cents of the month",
+                    "type": "integer"
                 }
             }
         }
@@ -2,13 +2,19 @@ package auth
 
 import (
 	"context"
+	"crypto/subtle"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/kerhael/accounting/internal/handler/utils"
 )
 
+// HealthSecretHeader carries the shared secret of the detailed health check.
+const HealthSecretHeader = "X-Health-Secret"
+
 type contextKey struct{}
 
 var userIDKey = contextKey{}
@@ -53,6 +59,52 @@ func AuthMiddleware(jwtService *JWTService) func(http.Handler) http.Handler {
 	}
 }
 
+// AdminMiddleware only lets through users holding the admin role, that is whose ID
+// is in adminUserIDs. It must run after AuthMiddleware.
+func AdminMiddleware(adminUserIDs []int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, ok := GetUserIDFromContext(r.Context())
+			if !ok {
+				utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+				return
+			}
+
+			if !slices.Contains(adminUserIDs, userID) {
+				utils.WriteJSONError(w, http.StatusForbidden, "admin role required")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// SharedSecretMiddleware only lets through requests whose header holds the given
+// secret. An empty secret disables the check.
+func SharedSecretMiddleware(header string, secret string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if secret != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(header)), []byte(secret)) != 1 {
+				utils.WriteJSONError(w, http.StatusUnauthorized, "invalid or missing "+header+" header")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// UserIDKey keys rate limits by authenticated user, returning "" for anonymous
+// requests. The limiter must then run after AuthMiddleware.
+func UserIDKey(r *http.Request) string {
+	userID, ok := GetUserIDFromContext(r.Context())
+	if !ok {
+		return ""
+	}
+	return "user:" + strconv.Itoa(userID)
+}
+
 func GetUserIDFromContext(ctx context.Context) (int, bool) {
 	userID, ok := ctx.Value(userIDKey).(int)
 	return userID, ok
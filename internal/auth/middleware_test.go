@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestSharedSecretMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		secret         string
+		header         string
+		expectedStatus int
+	}{
+		{name: "missing secret is rejected", secret: "health-secret", header: "", expectedStatus: http.StatusUnauthorized},
+		{name: "wrong secret is rejected", secret: "health-secret", header: "other-secret", expectedStatus: http.StatusUnauthorized},
+		{name: "correct secret is allowed", secret: "health-secret", header: "health-secret", expectedStatus: http.StatusOK},
+		{name: "no configured secret leaves the route open", secret: "", header: "", expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := SharedSecretMiddleware(HealthSecretHeader, tt.secret)(http.HandlerFunc(okHandler))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+			if tt.header != "" {
+				req.Header.Set(HealthSecretHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected %d, got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestAdminMiddleware(t *testing.T) {
+	handler := AdminMiddleware([]int{1})(http.HandlerFunc(okHandler))
+
+	for userID, expectedStatus := range map[int]int{1: http.StatusOK, 2: http.StatusForbidden} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/config", nil)
+		req = req.WithContext(ContextWithUserIDForTests(req.Context(), userID))
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		if w.Code != expectedStatus {
+			t.Errorf("user %d: expected %d, got %d", userID, expectedStatus, w.Code)
+		}
+	}
+}
@@ -3,7 +3,30 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
+)
+
+const (
+	DefaultMaxNameLength = 120
+	DefaultMaxBulkItems  = 1000
+	DefaultTimezone      = "UTC"
+	DefaultCORSOrigins   = ""
+	DefaultCORSMaxAge    = 600 * time.Second
+	DefaultBasePath      = "/api/v1/"
+
+	// DefaultCategoryLabels are the starter categories created for new users.
+	DefaultCategoryLabels = "Groceries,Housing,Transport,Health,Leisure"
+
+	DefaultCRUDTimeout    = 10 * time.Second
+	DefaultReportsTimeout = 60 * time.Second
+
+	DefaultSlowQueryThreshold = 500 * time.Millisecond
+
+	// DefaultJWTSecretMinLength is the default minimum JWT secret length in bytes,
+	// the HS256 key size.
+	DefaultJWTSecretMinLength = 32
 )
 
 type DatabaseConfig struct {
@@ -15,9 +38,78 @@ type DatabaseConfig struct {
 	SSLMode  string
 }
 
+type ValidationConfig struct {
+	MaxNameLength           int
+	NormalizeCategoryLabels bool
+	ClampFutureTo           bool // Cap future 'to' dates to now on date-filtered reads
+	RejectNumericNames      bool // Reject outcome and income names made only of digits
+	MaxBulkItems            int  // Items accepted by bulk requests
+	// FreezeDate closes the books: outcomes and incomes created before it can no
+	// longer be edited or deleted. Nil leaves every record editable.
+	FreezeDate *time.Time
+}
+
+type RateLimitConfig struct {
+	Rate  float64 // Requests per second
+	Burst int
+}
+
+type CORSConfig struct {
+	AllowedOrigins []string
+	MaxAge         time.Duration
+}
+
+// TimeoutConfig holds the request deadlines of the route groups: quick CRUD
+// routes, and report routes (sums, series, stats...) which can take longer.
+type TimeoutConfig struct {
+	CRUD    time.Duration
+	Reports time.Duration
+}
+
 type Config struct {
-	Database  DatabaseConfig
-	JWTSecret string
+	Database      DatabaseConfig
+	JWTSecret     string
+	Validation    ValidationConfig
+	Timezone      *time.Location
+	CORS          CORSConfig
+	AuthRateLimit RateLimitConfig // Login, token refresh and signup
+	ReadRateLimit RateLimitConfig // Authenticated GET requests
+	// ExportRateLimit applies per user to the export routes, which are costly
+	// to build, on top of ReadRateLimit.
+	ExportRateLimit RateLimitConfig
+	AdminUserIDs    []int  // Users holding the admin role
+	HealthSecret    string // Shared secret required by the detailed health check, empty to leave it open
+	Timeouts        TimeoutConfig
+	// MaxConcurrentRequests caps the requests served at once, extra requests
+	// being shed with a 503. Zero leaves it unlimited.
+	MaxConcurrentRequests int
+	// SlowQueryThreshold is the duration over which database queries are logged
+	// as slow. Zero disables the logging.
+	SlowQueryThreshold time.Duration
+	// BasePath prefixes the API routes, with a leading and a trailing slash
+	BasePath string
+	// DefaultCategories are the labels of the starter categories of new users
+	DefaultCategories []string
+}
+
+func DefaultAuthRateLimit() RateLimitConfig {
+	return RateLimitConfig{Rate: 1, Burst: 5}
+}
+
+func DefaultReadRateLimit() RateLimitConfig {
+	return RateLimitConfig{Rate: 10, Burst: 30}
+}
+
+// DefaultExportRateLimit allows an export every 20 seconds, after a burst of 3.
+func DefaultExportRateLimit() RateLimitConfig {
+	return RateLimitConfig{Rate: 0.05, Burst: 3}
+}
+
+func DefaultValidationConfig() ValidationConfig {
+	return ValidationConfig{
+		MaxNameLength: DefaultMaxNameLength,
+		MaxBulkItems:  DefaultMaxBulkItems,
+	}
 }
 
 func Load() (*Config, error) {
@@ -40,13 +132,162 @@ func Load() (*Config, error) {
 	if os.Getenv("DB_SSLMODE") == "" {
 		cfgErr = append(cfgErr, "DB_SSLMODE")
 	}
-	if os.Getenv("JWT_SECRET") == "" {
+	if strings.TrimSpace(os.Getenv("JWT_SECRET")) == "" {
 		cfgErr = append(cfgErr, "JWT_SECRET")
 	}
 	if len(cfgErr) > 0 {
 		return nil, fmt.Errorf("missing %s", strings.Join(cfgErr, ","))
 	}
 
+	jwtSecretMinLength := DefaultJWTSecretMinLength
+	if v := os.Getenv("JWT_SECRET_MIN_LENGTH"); v != "" {
+		minLength, err := strconv.Atoi(v)
+		if err != nil || minLength <= 0 {
+			return nil, fmt.Errorf("invalid JWT_SECRET_MIN_LENGTH %q", v)
+		}
+		jwtSecretMinLength = minLength
+	}
+	if len(strings.TrimSpace(os.Getenv("JWT_SECRET"))) < jwtSecretMinLength {
+		return nil, fmt.Errorf("JWT_SECRET must be at least %d characters long", jwtSecretMinLength)
+	}
+
+	validation := DefaultValidationConfig()
+	if v := os.Getenv("MAX_NAME_LENGTH"); v != "" {
+		maxNameLength, err := strconv.Atoi(v)
+		if err != nil || maxNameLength <= 0 {
+			return nil, fmt.Errorf("invalid MAX_NAME_LENGTH %q", v)
+		}
+		validation.MaxNameLength = maxNameLength
+	}
+
+	if v := os.Getenv("MAX_BULK_ITEMS"); v != "" {
+		maxBulkItems, err := strconv.Atoi(v)
+		if err != nil || maxBulkItems <= 0 {
+			return nil, fmt.Errorf("invalid MAX_BULK_ITEMS %q", v)
+		}
+		validation.MaxBulkItems = maxBulkItems
+	}
+
+	if v := os.Getenv("NORMALIZE_CATEGORY_LABELS"); v != "" {
+		normalize, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NORMALIZE_CATEGORY_LABELS %q", v)
+		}
+		validation.NormalizeCategoryLabels = normalize
+	}
+
+	if v := os.Getenv("CLAMP_FUTURE_TO"); v != "" {
+		clamp, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CLAMP_FUTURE_TO %q", v)
+		}
+		validation.ClampFutureTo = clamp
+	}
+
+	if v := os.Getenv("REJECT_NUMERIC_NAMES"); v != "" {
+		reject, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid REJECT_NUMERIC_NAMES %q", v)
+		}
+		validation.RejectNumericNames = reject
+	}
+
+	timezone := DefaultTimezone
+	if v := os.Getenv("TIMEZONE"); v != "" {
+		timezone = v
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TIMEZONE %q: %w", timezone, err)
+	}
+
+	if v := os.Getenv("FREEZE_DATE"); v != "" {
+		freezeDate, err := time.ParseInLocation(time.DateOnly, v, location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid FREEZE_DATE %q", v)
+		}
+		validation.FreezeDate = &freezeDate
+	}
+
+	corsOrigins := DefaultCORSOrigins
+	if v := os.Getenv("CORS_ALLOWED_ORIGINS"); v != "" {
+		corsOrigins = v
+	}
+	cors := CORSConfig{
+		AllowedOrigins: splitList(corsOrigins),
+		MaxAge:         DefaultCORSMaxAge,
+	}
+	if v := os.Getenv("CORS_MAX_AGE"); v != "" {
+		maxAge, err := strconv.Atoi(v)
+		if err != nil || maxAge < 0 {
+			return nil, fmt.Errorf("invalid CORS_MAX_AGE %q", v)
+		}
+		cors.MaxAge = time.Duration(maxAge) * time.Second
+	}
+
+	authRateLimit, err := loadRateLimit("AUTH", DefaultAuthRateLimit())
+	if err != nil {
+		return nil, err
+	}
+	readRateLimit, err := loadRateLimit("READ", DefaultReadRateLimit())
+	if err != nil {
+		return nil, err
+	}
+	exportRateLimit, err := loadRateLimit("EXPORT", DefaultExportRateLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	timeouts := TimeoutConfig{
+		CRUD:    DefaultCRUDTimeout,
+		Reports: DefaultReportsTimeout,
+	}
+	if timeouts.CRUD, err = loadTimeout("CRUD_TIMEOUT", timeouts.CRUD); err != nil {
+		return nil, err
+	}
+	if timeouts.Reports, err = loadTimeout("REPORTS_TIMEOUT", timeouts.Reports); err != nil {
+		return nil, err
+	}
+
+	defaultCategories := DefaultCategoryLabels
+	if v := os.Getenv("DEFAULT_CATEGORIES"); v != "" {
+		defaultCategories = v
+	}
+
+	slowQueryThreshold := DefaultSlowQueryThreshold
+	if v := os.Getenv("SLOW_QUERY_THRESHOLD_MS"); v != "" {
+		ms, err := strconv.Atoi(v)
+		if err != nil || ms < 0 {
+			return nil, fmt.Errorf("invalid SLOW_QUERY_THRESHOLD_MS %q", v)
+		}
+		slowQueryThreshold = time.Duration(ms) * time.Millisecond
+	}
+
+	basePath := DefaultBasePath
+	if v := os.Getenv("BASE_PATH"); v != "" {
+		if !strings.HasPrefix(v, "/") || strings.ContainsAny(v, " {}") {
+			return nil, fmt.Errorf("invalid BASE_PATH %q", v)
+		}
+		basePath = strings.TrimSuffix(v, "/") + "/"
+	}
+
+	var maxConcurrentRequests int
+	if v := os.Getenv("MAX_CONCURRENT_REQUESTS"); v != "" {
+		maxConcurrentRequests, err = strconv.Atoi(v)
+		if err != nil || maxConcurrentRequests < 0 {
+			return nil, fmt.Errorf("invalid MAX_CONCURRENT_REQUESTS %q", v)
+		}
+	}
+
+	var adminUserIDs []int
+	for _, v := range splitList(os.Getenv("ADMIN_USER_IDS")) {
+		id, err := strconv.Atoi(v)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid ADMIN_USER_IDS entry %q", v)
+		}
+		adminUserIDs = append(adminUserIDs, id)
+	}
+
 	cfg := &Config{
 		Database: DatabaseConfig{
 			Host:     os.Getenv("DB_HOST"),
@@ -56,8 +297,67 @@ func Load() (*Config, error) {
 			Name:     os.Getenv("DB_NAME"),
 			SSLMode:  os.Getenv("DB_SSLMODE"),
 		},
-		JWTSecret: os.Getenv("JWT_SECRET"),
+		JWTSecret:       os.Getenv("JWT_SECRET"),
+		Validation:      validation,
+		Timezone:        location,
+		CORS:            cors,
+		AuthRateLimit:   authRateLimit,
+		ReadRateLimit:   readRateLimit,
+		ExportRateLimit: exportRateLimit,
+		AdminUserIDs:    adminUserIDs,
+		HealthSecret:    os.Getenv("HEALTH_SECRET"),
+		Timeouts:        timeouts,
+
+		DefaultCategories:     splitList(defaultCategories),
+		MaxConcurrentRequests: maxConcurrentRequests,
+		SlowQueryThreshold:    slowQueryThreshold,
+		BasePath:              basePath,
 	}
 
 	return cfg, nil
 }
+
+// loadRateLimit reads <prefix>_RATE_LIMIT and <prefix>_RATE_BURST, falling back to def.
+func loadRateLimit(prefix string, def RateLimitConfig) (RateLimitConfig, error) {
+	cfg := def
+	if v := os.Getenv(prefix + "_RATE_LIMIT"); v != "" {
+		r, err := strconv.ParseFloat(v, 64)
+		if err != nil || r <= 0 {
+			return cfg, fmt.Errorf("invalid %s_RATE_LIMIT %q", prefix, v)
+		}
+		cfg.Rate = r
+	}
+	if v := os.Getenv(prefix + "_RATE_BURST"); v != "" {
+		burst, err := strconv.Atoi(v)
+		if err != nil || burst <= 0 {
+			return cfg, fmt.Errorf("invalid %s_RATE_BURST %q", prefix, v)
+		}
+		cfg.Burst = burst
+	}
+	return cfg, nil
+}
+
+// loadTimeout reads a timeout in seconds from the given variable, falling back to def.
+func loadTimeout(name string, def time.Duration) (time.Duration, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return def, nil
+	}
+	seconds, err := strconv.Atoi(v)
+	if err != nil || seconds <= 0 {
+		return def, fmt.Errorf("invalid %s %q", name, v)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// splitList splits a comma separated value, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
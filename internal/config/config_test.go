@@ -0,0 +1,237 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setRequiredEnv(t *testing.T) {
+	t.Setenv("DB_HOST", "localhost")
+	t.Setenv("DB_PORT", "5432")
+	t.Setenv("DB_USER", "accounting")
+	t.Setenv("DB_PASSWORD", "password")
+	t.Setenv("DB_NAME", "accounting")
+	t.Setenv("DB_SSLMODE", "disable")
+	t.Setenv("JWT_SECRET", strings.Repeat("s", DefaultJWTSecretMinLength))
+}
+
+func TestLoad_CORSAllowedOrigins(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Empty(t, cfg.CORS.AllowedOrigins)
+
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://app.example.com, https://admin.example.com")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://app.example.com", "https://admin.example.com"}, cfg.CORS.AllowedOrigins)
+
+	t.Setenv("CORS_ALLOWED_ORIGINS", "*")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"*"}, cfg.CORS.AllowedOrigins)
+}
+
+func TestLoad_Success(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("s", DefaultJWTSecretMinLength), cfg.JWTSecret)
+}
+
+func TestLoad_EmptyJWTSecret(t *testing.T) {
+	setRequiredEnv(t)
+
+	for _, secret := range []string{"", "   "} {
+		t.Setenv("JWT_SECRET", secret)
+
+		cfg, err := Load()
+
+		assert.Nil(t, cfg)
+		assert.EqualError(t, err, "missing JWT_SECRET")
+	}
+}
+
+func TestLoad_ShortJWTSecret(t *testing.T) {
+	setRequiredEnv(t)
+
+	// Surrounding whitespace does not count towards the length
+	for _, secret := range []string{
+		strings.Repeat("s", DefaultJWTSecretMinLength-1),
+		"  " + strings.Repeat("s", DefaultJWTSecretMinLength-1) + "  ",
+	} {
+		t.Setenv("JWT_SECRET", secret)
+
+		cfg, err := Load()
+
+		assert.Nil(t, cfg)
+		assert.EqualError(t, err, "JWT_SECRET must be at least 32 characters long")
+	}
+}
+
+func TestLoad_JWTSecretMinLength(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("JWT_SECRET_MIN_LENGTH", "48")
+
+	cfg, err := Load()
+	assert.Nil(t, cfg)
+	assert.EqualError(t, err, "JWT_SECRET must be at least 48 characters long")
+
+	t.Setenv("JWT_SECRET", strings.Repeat("s", 48))
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("s", 48), cfg.JWTSecret)
+
+	for _, v := range []string{"0", "-1", "abc"} {
+		t.Setenv("JWT_SECRET_MIN_LENGTH", v)
+
+		cfg, err := Load()
+
+		assert.Nil(t, cfg)
+		assert.EqualError(t, err, "invalid JWT_SECRET_MIN_LENGTH \""+v+"\"")
+	}
+}
+
+func TestLoad_Timeouts(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, TimeoutConfig{CRUD: DefaultCRUDTimeout, Reports: DefaultReportsTimeout}, cfg.Timeouts)
+
+	t.Setenv("CRUD_TIMEOUT", "5")
+	t.Setenv("REPORTS_TIMEOUT", "120")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, TimeoutConfig{CRUD: 5 * time.Second, Reports: 120 * time.Second}, cfg.Timeouts)
+
+	t.Setenv("REPORTS_TIMEOUT", "0")
+
+	_, err = Load()
+	assert.EqualError(t, err, `invalid REPORTS_TIMEOUT "0"`)
+}
+
+func TestLoad_FreezeDate(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Nil(t, cfg.Validation.FreezeDate)
+
+	t.Setenv("TIMEZONE", "Europe/Paris")
+	t.Setenv("FREEZE_DATE", "2026-01-01")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, cfg.Timezone), *cfg.Validation.FreezeDate)
+
+	t.Setenv("FREEZE_DATE", "2026-13-01")
+
+	_, err = Load()
+	assert.EqualError(t, err, `invalid FREEZE_DATE "2026-13-01"`)
+}
+
+func TestLoad_MaxBulkItems(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultMaxBulkItems, cfg.Validation.MaxBulkItems)
+
+	t.Setenv("MAX_BULK_ITEMS", "50")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 50, cfg.Validation.MaxBulkItems)
+
+	t.Setenv("MAX_BULK_ITEMS", "0")
+
+	_, err = Load()
+	assert.EqualError(t, err, `invalid MAX_BULK_ITEMS "0"`)
+}
+
+func TestLoad_ExportRateLimit(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultExportRateLimit(), cfg.ExportRateLimit)
+
+	t.Setenv("EXPORT_RATE_LIMIT", "0.01")
+	t.Setenv("EXPORT_RATE_BURST", "1")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, RateLimitConfig{Rate: 0.01, Burst: 1}, cfg.ExportRateLimit)
+
+	t.Setenv("EXPORT_RATE_LIMIT", "0")
+
+	_, err = Load()
+	assert.EqualError(t, err, `invalid EXPORT_RATE_LIMIT "0"`)
+}
+
+func TestLoad_SlowQueryThreshold(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultSlowQueryThreshold, cfg.SlowQueryThreshold)
+
+	t.Setenv("SLOW_QUERY_THRESHOLD_MS", "0")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Zero(t, cfg.SlowQueryThreshold)
+
+	t.Setenv("SLOW_QUERY_THRESHOLD_MS", "1.5s")
+
+	_, err = Load()
+	assert.EqualError(t, err, `invalid SLOW_QUERY_THRESHOLD_MS "1.5s"`)
+}
+
+func TestLoad_BasePath(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "/api/v1/", cfg.BasePath)
+
+	t.Setenv("BASE_PATH", "/accounting/api/v1")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "/accounting/api/v1/", cfg.BasePath)
+
+	t.Setenv("BASE_PATH", "api/v1/")
+
+	_, err = Load()
+	assert.EqualError(t, err, `invalid BASE_PATH "api/v1/"`)
+}
+
+func TestLoad_MaxConcurrentRequests(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, cfg.MaxConcurrentRequests)
+
+	t.Setenv("MAX_CONCURRENT_REQUESTS", "200")
+
+	cfg, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 200, cfg.MaxConcurrentRequests)
+
+	t.Setenv("MAX_CONCURRENT_REQUESTS", "-1")
+
+	_, err = Load()
+	assert.EqualError(t, err, `invalid MAX_CONCURRENT_REQUESTS "-1"`)
+}
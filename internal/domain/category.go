@@ -1,7 +1,21 @@
 package domain
 
+import "time"
+
+// DefaultStaleMonths is how long a category goes without outcomes before being
+// suggested for archiving.
+const DefaultStaleMonths = 6
+
 type Category struct {
 	Label  string
+	Color  string // Hex color (#RRGGBB), empty if not set
 	ID     int
 	UserId int
 }
+
+// CategoryUsage is a category along with how it is used by outcomes.
+type CategoryUsage struct {
+	Category
+	LastUsedAt   *time.Time // Date of the most recent outcome, nil if never used
+	OutcomeCount int        // Lifetime number of outcomes
+}
@@ -41,3 +41,17 @@ func (e *EntityNotFoundError) Error() string {
 func (e *EntityNotFoundError) Unwrap() error {
 	return e.UnderlyingCause
 }
+
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+// BulkError reports the items of a bulk operation that failed, by index.
+type BulkError struct {
+	Errors []IndexedError
+}
+
+func (e *BulkError) Error() string {
+	return fmt.Sprintf("%d item(s) failed", len(e.Errors))
+}
@@ -0,0 +1,7 @@
+package domain
+
+type OnboardingStatus struct {
+	HasCategories bool
+	HasIncome     bool
+	HasOutcome    bool
+}
@@ -6,14 +6,92 @@ type Outcome struct {
 	Name       string
 	CreatedAt  *time.Time
 	Amount     int
-	CategoryId int
+	CategoryId int // 0 when uncategorized
 	ID         int
 	UserId     int
 }
 
+// OutcomePatch describes a partial update of an outcome, zero values leaving
+// the field unchanged.
+type OutcomePatch struct {
+	ID         int
+	Name       string
+	Amount     int
+	CategoryId int
+	CreatedAt  *time.Time
+}
+
+// MaxExportedOutcomes caps the outcomes of an export, which is built in memory.
+const MaxExportedOutcomes = 50000
+
+// ExportedOutcome is an outcome along with the label of its category, as listed
+// in exports.
+type ExportedOutcome struct {
+	Outcome
+	CategoryLabel string
+}
+
 type CategorySum struct {
 	CategoryId int
 	Total      int
+	Count      int
+}
+
+type CategoryAverage struct {
+	CategoryId int
+	Average    int
+	Count      int
+}
+
+// OutcomeOutlier is an outcome whose amount exceeds its category's average
+// over the same window by a given factor.
+type OutcomeOutlier struct {
+	Outcome
+	CategoryAverage int
+}
+
+// NameFrequency is how often outcomes of a given name were logged, such as
+// the purchases at a merchant.
+type NameFrequency struct {
+	Name  string
+	Count int
+	Total int
+}
+
+type HourlySum struct {
+	Hour  int
+	Total int
+}
+
+type DailySum struct {
+	Day   string
+	Total int
+}
+
+// Streak is a run of consecutive days, From and To being YYYY-MM-DD dates
+// left empty when the run is zero days long.
+type Streak struct {
+	Days int
+	From string
+	To   string
+}
+
+type SpendingStreaks struct {
+	LongestSpending Streak
+	LongestNoSpend  Streak
+}
+
+type RollingTotal struct {
+	From  time.Time
+	To    time.Time
+	Total int
+}
+
+type SpendingProjection struct {
+	SpentSoFar     int
+	DaysElapsed    int
+	DaysInMonth    int
+	ProjectedTotal int
 }
 
 type MonthlySeries struct {
@@ -21,7 +99,30 @@ type MonthlySeries struct {
 	Categories map[int]int
 }
 
+type MonthlyForecast struct {
+	Month      string
+	Categories map[int]int
+	Total      int
+}
+
+// YearOverYear compares a month's outcomes total with the same month a year
+// earlier. Percent is the change relative to the previous total, nil when there
+// was no spending the previous year.
+type YearOverYear struct {
+	Month         string
+	Total         int
+	PreviousMonth string
+	PreviousTotal int
+	Delta         int
+	Percent       *float64
+}
+
 type MonthlyTotalSeries struct {
 	Month string
 	Total int
 }
+
+type MonthlyCount struct {
+	Month string
+	Count int
+}
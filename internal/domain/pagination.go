@@ -1,7 +1,16 @@
 package domain
 
+import "time"
+
 const (
 	DefaultOffset = 0
 	DefaultLimit  = 20
 	MaxLimit      = 100
 )
+
+// Cursor is the (created_at, id) key of the last item of a page, used for
+// keyset pagination.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int
+}
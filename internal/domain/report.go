@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// CategoryIncomeShare is a category's spend expressed as a percentage of income.
+// PercentOfIncome is nil when there is no income for the period.
+type CategoryIncomeShare struct {
+	CategoryId      int
+	Total           int
+	PercentOfIncome *float64
+}
+
+// SpendVsIncome compares the spend of a period with its income. SavingsRate is the
+// share of income left after spending, nil when there is no income for the period.
+type SpendVsIncome struct {
+	Income      int
+	Spent       int
+	SavingsRate *float64
+	Categories  []CategoryIncomeShare
+}
+
+// PayPeriodBalance is the net cashflow of a pay period, From and To being its
+// first and last instants.
+type PayPeriodBalance struct {
+	From   time.Time
+	To     time.Time
+	Income int
+	Spent  int
+	Net    int
+}
+
+// MonthlySavingsRate compares a month's income with its outcomes. SavingsRate is
+// the share of income left after spending, nil when there is no income that month.
+type MonthlySavingsRate struct {
+	Month       string
+	Income      int
+	Outcome     int
+	SavingsRate *float64
+}
+
+// MonthlyStatement sums up the cashflow of a month. Categories holds the spend of
+// each category having outcomes that month, the largest first.
+type MonthlyStatement struct {
+	Income     int
+	Outcome    int
+	Net        int
+	Categories []CategoryStatementLine
+}
+
+// CategoryStatementLine is a category's spend in a monthly statement, Label being
+// empty for uncategorized outcomes.
+type CategoryStatementLine struct {
+	Label string
+	Total int
+}
@@ -0,0 +1,72 @@
+package domain
+
+import "time"
+
+const (
+	DefaultLargestLimit      = 5
+	DefaultFrequentLimit     = 10
+	DefaultRollingWindowDays = 30
+	MaxRollingWindowDays     = 365
+	MaxStreakDays            = 3660
+	DefaultOutlierFactor     = 2.0
+	DefaultForecastMonths    = 3
+	MaxForecastMonths        = 12
+	ForecastHistoryMonths    = 6
+	DefaultPayPeriodDays     = 14
+	MaxPayPeriodDays         = 365
+	MaxHistogramBuckets      = 20
+	MaxGroupedPeriods        = 400
+)
+
+// Interval is a period length outcomes are grouped by.
+type Interval string
+
+const (
+	IntervalDay     Interval = "day"
+	IntervalWeek    Interval = "week"
+	IntervalMonth   Interval = "month"
+	IntervalQuarter Interval = "quarter"
+	IntervalYear    Interval = "year"
+)
+
+// Intervals lists the supported intervals, shortest first.
+var Intervals = []Interval{IntervalDay, IntervalWeek, IntervalMonth, IntervalQuarter, IntervalYear}
+
+// PeriodSum is the total and number of outcomes of a period, Start being its
+// first day (YYYY-MM-DD). Weeks start on Monday.
+type PeriodSum struct {
+	Start string
+	Total int
+	Count int
+}
+
+// DefaultHistogramBuckets are the lower bounds in cents of the default amount
+// histogram buckets: under 10, 10 to 50, 50 to 100, 100 to 500 and 500 and over.
+var DefaultHistogramBuckets = []int{0, 1000, 5000, 10000, 50000}
+
+// AmountCount is the number of outcomes of a given amount.
+type AmountCount struct {
+	Amount int
+	Count  int
+}
+
+// AmountBucket holds the outcomes whose amount is in [Min, Max).
+type AmountBucket struct {
+	Min   int
+	Max   *int // nil for the last, unbounded bucket
+	Count int
+	Total int
+}
+
+type AmountStats struct {
+	Count   int
+	Min     int
+	Max     int
+	Average int
+	Median  int
+}
+
+type DateBounds struct {
+	Min time.Time
+	Max time.Time
+}
@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+const (
+	TransactionTypeIncome  = "income"
+	TransactionTypeOutcome = "outcome"
+)
+
+// Transaction is an income or an outcome, discriminated by Type. CategoryId is
+// zero for incomes.
+type Transaction struct {
+	Type       string
+	ID         int
+	Name       string
+	Amount     int
+	CategoryId int
+	CreatedAt  *time.Time
+}
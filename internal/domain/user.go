@@ -8,7 +8,12 @@ type User struct {
 	LastName     string
 	Email        string
 	PasswordHash string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	DeletedAt    time.Time
+	// DefaultCategoryId is used for outcomes created without a category; 0 means unset.
+	DefaultCategoryId int
+	// RequireCategory rejects outcomes without a category, otherwise they may be
+	// left uncategorized.
+	RequireCategory bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       time.Time
 }
@@ -3,50 +3,84 @@ package handler
 import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/config"
 	v1 "github.com/kerhael/accounting/internal/handler/v1"
 	"github.com/kerhael/accounting/internal/infrastructure/repository"
 	"github.com/kerhael/accounting/internal/service"
+	"github.com/kerhael/accounting/pkg/logger"
 )
 
 type HandlersV1 struct {
-	Health   *v1.HealthHandler
-	Category *v1.CategoryHandler
-	Outcomes *v1.OutcomeHandler
-	Incomes  *v1.IncomeHandler
-	Users    *v1.UserHandler
-	Auth     *v1.AuthHandler
+	Health       *v1.HealthHandler
+	Time         *v1.TimeHandler
+	Category     *v1.CategoryHandler
+	Outcomes     *v1.OutcomeHandler
+	Incomes      *v1.IncomeHandler
+	Users        *v1.UserHandler
+	Onboarding   *v1.OnboardingHandler
+	Reports      *v1.ReportHandler
+	Transactions *v1.TransactionHandler
+	Auth         *v1.AuthHandler
+	Admin        *v1.AdminHandler
 }
 
 type Handlers struct {
-	V1  *HandlersV1
-	JWT *auth.JWTService
+	V1           *HandlersV1
+	JWT          *auth.JWTService
+	AdminUserIDs []int
+	HealthSecret string
+	BasePath     string // Prefix of the API routes, such as "/api/v1/"
 }
 
-func NewHandlers(db *pgxpool.Pool, jwtService *auth.JWTService) *Handlers {
+func NewHandlers(db *pgxpool.Pool, jwtService *auth.JWTService, cfg *config.Config) *Handlers {
 	healthRepo := repository.NewHealthRepository(db)
 	healthService := service.NewHealthService(healthRepo)
 
-	categoryRepo := repository.NewCategoryRepository(db)
-	categoryService := service.NewCategoryService(categoryRepo)
+	timeRepo := repository.NewTimeRepository(db)
+	timeService := service.NewTimeService(timeRepo, cfg.Timezone)
 
-	outcomeRepo := repository.NewOutcomeRepository(db)
-	outcomeService := service.NewOutcomeService(outcomeRepo, categoryRepo)
+	// Repositories log their slow queries
+	var conn repository.DB = db
+	if cfg.SlowQueryThreshold > 0 {
+		conn = repository.NewSlowQueryDB(db, cfg.SlowQueryThreshold, logger.New())
+	}
+
+	categoryRepo := repository.NewCategoryRepository(conn)
+	categoryService := service.NewCategoryService(categoryRepo, cfg.Validation, cfg.DefaultCategories)
+
+	outcomeRepo := repository.NewOutcomeRepository(conn)
+	outcomeService := service.NewOutcomeService(outcomeRepo, categoryRepo, cfg.Validation)
+
+	incomeRepo := repository.NewIncomeRepository(conn)
+	incomeService := service.NewIncomeService(incomeRepo, cfg.Validation)
+
+	userRepo := repository.NewUserRepository(conn)
+	userService := service.NewUserService(userRepo, categoryRepo)
+
+	onboardingService := service.NewOnboardingService(categoryRepo, incomeRepo, outcomeRepo)
 
-	incomeRepo := repository.NewIncomeRepository(db)
-	incomeService := service.NewIncomeService(incomeRepo)
+	reportService := service.NewReportService(incomeRepo, outcomeRepo, categoryRepo)
 
-	userRepo := repository.NewUserRepository(db)
-	userService := service.NewUserService(userRepo)
+	transactionRepo := repository.NewTransactionRepository(conn)
+	transactionService := service.NewTransactionService(transactionRepo)
 
 	return &Handlers{
-		JWT: jwtService,
+		JWT:          jwtService,
+		AdminUserIDs: cfg.AdminUserIDs,
+		HealthSecret: cfg.HealthSecret,
+		BasePath:     cfg.BasePath,
 		V1: &HandlersV1{
-			Health:   v1.NewHealthHandler(healthService),
-			Category: v1.NewCategoryHandler(categoryService),
-			Outcomes: v1.NewOutcomeHandler(outcomeService),
-			Incomes:  v1.NewIncomeHandler(incomeService),
-			Users:    v1.NewUserHandler(userService),
-			Auth:     v1.NewAuthHandler(userService, jwtService),
+			Health:       v1.NewHealthHandler(healthService),
+			Time:         v1.NewTimeHandler(timeService),
+			Category:     v1.NewCategoryHandler(categoryService, cfg.Timezone),
+			Outcomes:     v1.NewOutcomeHandler(outcomeService, cfg.Timezone, cfg.Validation.MaxBulkItems),
+			Incomes:      v1.NewIncomeHandler(incomeService, cfg.Timezone),
+			Users:        v1.NewUserHandler(userService),
+			Onboarding:   v1.NewOnboardingHandler(onboardingService),
+			Reports:      v1.NewReportHandler(reportService, cfg.Timezone),
+			Transactions: v1.NewTransactionHandler(transactionService),
+			Auth:         v1.NewAuthHandler(userService, jwtService),
+			Admin:        v1.NewAdminHandler(cfg),
 		},
 	}
 }
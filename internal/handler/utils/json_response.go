@@ -2,6 +2,7 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/kerhael/accounting/internal/domain"
@@ -26,3 +27,26 @@ func WriteJSONError(w http.ResponseWriter, status int, message string) {
 		return
 	}
 }
+
+// ErrorStatus maps the errors returned by the services to HTTP statuses: 400 for
+// an invalid date range, 422 Unprocessable Entity for values that failed business
+// validation, 404 for a missing entity and 500 for anything else. Malformed input
+// caught by the handlers themselves (e.g. undecodable JSON) is answered with a 400
+// through WriteJSONError.
+func ErrorStatus(err error) int {
+	if _, ok := errors.AsType[*domain.InvalidDateError](err); ok {
+		return http.StatusBadRequest
+	}
+	if _, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
+		return http.StatusUnprocessableEntity
+	}
+	if _, ok := errors.AsType[*domain.EntityNotFoundError](err); ok {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// WriteError answers a service error with the status ErrorStatus maps it to.
+func WriteError(w http.ResponseWriter, err error) {
+	WriteJSONError(w, ErrorStatus(err), err.Error())
+}
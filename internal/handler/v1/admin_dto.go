@@ -0,0 +1,49 @@
+package v1
+
+// ConfigResponse is the effective configuration, secrets such as the database
+// password and the JWT secret are left out.
+type ConfigResponse struct {
+	Timezone        string                   `json:"timezone"` // Configured timezone (ex: "Europe/Paris")
+	Database        DatabaseConfigResponse   `json:"database"`
+	Validation      ValidationConfigResponse `json:"validation"`
+	CORS            CORSConfigResponse       `json:"cors"`
+	AuthRateLimit   RateLimitConfigResponse  `json:"authRateLimit"`   // Login, token refresh and signup
+	ReadRateLimit   RateLimitConfigResponse  `json:"readRateLimit"`   // Authenticated GET requests
+	ExportRateLimit RateLimitConfigResponse  `json:"exportRateLimit"` // Export routes, per user
+	Timeouts        TimeoutConfigResponse    `json:"timeouts"`
+	// MaxConcurrentRequests caps the requests served at once, 0 when unlimited
+	MaxConcurrentRequests int `json:"maxConcurrentRequests"`
+	// BasePath prefixes the API routes (ex: "/api/v1/")
+	BasePath string `json:"basePath"`
+}
+
+type DatabaseConfigResponse struct {
+	Host    string `json:"host"`
+	Port    string `json:"port"`
+	Name    string `json:"name"`
+	SSLMode string `json:"sslMode"`
+}
+
+type ValidationConfigResponse struct {
+	MaxNameLength           int    `json:"maxNameLength"`
+	MaxBulkItems            int    `json:"maxBulkItems"` // Items accepted by a bulk request
+	NormalizeCategoryLabels bool   `json:"normalizeCategoryLabels"`
+	ClampFutureTo           bool   `json:"clampFutureTo"`
+	RejectNumericNames      bool   `json:"rejectNumericNames"`
+	FreezeDate              string `json:"freezeDate,omitempty"` // YYYY-MM-DD, records created before it are read-only
+}
+
+type CORSConfigResponse struct {
+	AllowedOrigins []string `json:"allowedOrigins"`
+	MaxAge         int      `json:"maxAge"` // Preflight cache duration in seconds
+}
+
+type TimeoutConfigResponse struct {
+	CRUD    int `json:"crud"`    // CRUD request deadline in seconds
+	Reports int `json:"reports"` // Report request deadline in seconds
+}
+
+type RateLimitConfigResponse struct {
+	Rate  float64 `json:"rate"` // Requests per second
+	Burst int     `json:"burst"`
+}
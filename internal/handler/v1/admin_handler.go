@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kerhael/accounting/internal/config"
+	"github.com/kerhael/accounting/internal/handler/utils"
+)
+
+type AdminHandler struct {
+	cfg *config.Config
+}
+
+func NewAdminHandler(cfg *config.Config) *AdminHandler {
+	return &AdminHandler{cfg: cfg}
+}
+
+// Effective configuration
+// @Summary      Effective configuration
+// @Description Retrieve the non-sensitive effective configuration, to debug deployments. Secrets are never returned. Restricted to the admin role.
+// @Tags         admin
+// @Produce      json
+// @Success      200       {object}   ConfigResponse
+// @Failure      401       {object}  ErrorResponse  "Unauthorized error"
+// @Failure      403       {object}  ErrorResponse  "Forbidden error"
+// @Security BearerAuth
+// @Router       /admin/config [get]
+func (h *AdminHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	utils.WriteJSON(w, http.StatusOK, toConfigResponse(h.cfg))
+}
+
+func toConfigResponse(cfg *config.Config) ConfigResponse {
+	timezone := config.DefaultTimezone
+	if cfg.Timezone != nil {
+		timezone = cfg.Timezone.String()
+	}
+
+	basePath := config.DefaultBasePath
+	if cfg.BasePath != "" {
+		basePath = cfg.BasePath
+	}
+
+	var freezeDate string
+	if cfg.Validation.FreezeDate != nil {
+		freezeDate = cfg.Validation.FreezeDate.Format(time.DateOnly)
+	}
+
+	return ConfigResponse{
+		Timezone: timezone,
+		Database: DatabaseConfigResponse{
+			Host:    cfg.Database.Host,
+			Port:    cfg.Database.Port,
+			Name:    cfg.Database.Name,
+			SSLMode: cfg.Database.SSLMode,
+		},
+		Validation: ValidationConfigResponse{
+			MaxNameLength:           cfg.Validation.MaxNameLength,
+			MaxBulkItems:            cfg.Validation.MaxBulkItems,
+			NormalizeCategoryLabels: cfg.Validation.NormalizeCategoryLabels,
+			ClampFutureTo:           cfg.Validation.ClampFutureTo,
+			RejectNumericNames:      cfg.Validation.RejectNumericNames,
+			FreezeDate:              freezeDate,
+		},
+		CORS: CORSConfigResponse{
+			AllowedOrigins: cfg.CORS.AllowedOrigins,
+			MaxAge:         int(cfg.CORS.MaxAge.Seconds()),
+		},
+		AuthRateLimit: RateLimitConfigResponse{
+			Rate:  cfg.AuthRateLimit.Rate,
+			Burst: cfg.AuthRateLimit.Burst,
+		},
+		ReadRateLimit: RateLimitConfigResponse{
+			Rate:  cfg.ReadRateLimit.Rate,
+			Burst: cfg.ReadRateLimit.Burst,
+		},
+		ExportRateLimit: RateLimitConfigResponse{
+			Rate:  cfg.ExportRateLimit.Rate,
+			Burst: cfg.ExportRateLimit.Burst,
+		},
+		Timeouts: TimeoutConfigResponse{
+			CRUD:    int(cfg.Timeouts.CRUD.Seconds()),
+			Reports: int(cfg.Timeouts.Reports.Seconds()),
+		},
+		MaxConcurrentRequests: cfg.MaxConcurrentRequests,
+		BasePath:              basePath,
+	}
+}
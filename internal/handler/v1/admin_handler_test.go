@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminHandler_GetConfig_OmitsSecrets(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{
+			Host:     "db.internal",
+			Port:     "5432",
+			User:     "accounting",
+			Password: "db-password-value",
+			Name:     "accounting",
+			SSLMode:  "require",
+		},
+		JWTSecret:             "jwt-secret-value",
+		Validation:            config.DefaultValidationConfig(),
+		Timezone:              location,
+		CORS:                  config.CORSConfig{AllowedOrigins: []string{"https://app.example.com"}, MaxAge: config.DefaultCORSMaxAge},
+		AuthRateLimit:         config.DefaultAuthRateLimit(),
+		ReadRateLimit:         config.DefaultReadRateLimit(),
+		ExportRateLimit:       config.DefaultExportRateLimit(),
+		Timeouts:              config.TimeoutConfig{CRUD: config.DefaultCRUDTimeout, Reports: config.DefaultReportsTimeout},
+		MaxConcurrentRequests: 200,
+		BasePath:              "/accounting/api/v1/",
+	}
+	handler := NewAdminHandler(cfg)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/config", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetConfig(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	body := w.Body.String()
+	assert.NotContains(t, body, "db-password-value")
+	assert.NotContains(t, body, "jwt-secret-value")
+	assert.Contains(t, body, `"timezone":"Europe/Paris"`)
+	assert.Contains(t, body, `"host":"db.internal"`)
+	assert.Contains(t, body, `"maxNameLength":120`)
+	assert.Contains(t, body, `"maxBulkItems":1000`)
+	assert.Contains(t, body, `"authRateLimit":{"rate":1,"burst":5}`)
+	assert.Contains(t, body, `"exportRateLimit":{"rate":0.05,"burst":3}`)
+	assert.Contains(t, body, `"timeouts":{"crud":10,"reports":60}`)
+	assert.Contains(t, body, `"maxConcurrentRequests":200`)
+	assert.Contains(t, body, `"basePath":"/accounting/api/v1/"`)
+}
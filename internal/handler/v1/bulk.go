@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// decodeBulk decodes the JSON array of a bulk request item by item, failing as
+// soon as it holds more than maxItems items so oversized arrays are never fully
+// read. A zero maxItems disables the limit.
+func decodeBulk[T any](r io.Reader, maxItems int) ([]T, error) {
+	dec := json.NewDecoder(r)
+
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New("request body must be a JSON array")
+	}
+
+	var items []T
+	for dec.More() {
+		if maxItems > 0 && len(items) >= maxItems {
+			return nil, fmt.Errorf("at most %d items are allowed", maxItems)
+		}
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -1,10 +1,29 @@
 package v1
 
+import "time"
+
 type CreateCategoryRequest struct {
 	Label string `json:"label"`
+	Color string `json:"color"` // Hex color used in charts (optional, ex: "#FF8800")
+}
+
+type PatchCategoryByIdRequest struct {
+	Label *string `json:"label"` // Label of the category (optional)
+	Color *string `json:"color"` // Hex color used in charts (optional, ex: "#FF8800", "" clears it)
+}
+
+type CloneCategoryRequest struct {
+	Label string `json:"label"` // Label of the new category (optional, defaults to the source label suffixed with " (copy)")
 }
 
 type CategoryResponse struct {
 	ID    int    `json:"id"`
 	Label string `json:"label"`
+	Color string `json:"color,omitempty"`
+}
+
+type CategoryUsageResponse struct {
+	CategoryResponse
+	LastUsedAt   *time.Time `json:"lastUsedAt"` // Date of the most recent outcome, null if never used
+	OutcomeCount int        `json:"outcomeCount"`
 }
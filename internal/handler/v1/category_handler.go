@@ -3,8 +3,10 @@ package v1
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/kerhael/accounting/internal/auth"
 	"github.com/kerhael/accounting/internal/domain"
@@ -13,11 +15,12 @@ import (
 )
 
 type CategoryHandler struct {
-	service service.CategoryServiceInterface
+	service  service.CategoryServiceInterface
+	location *time.Location
 }
 
-func NewCategoryHandler(service service.CategoryServiceInterface) *CategoryHandler {
-	return &CategoryHandler{service: service}
+func NewCategoryHandler(service service.CategoryServiceInterface, location *time.Location) *CategoryHandler {
+	return &CategoryHandler{service: service, location: location}
 }
 
 // Create a category
@@ -30,6 +33,7 @@ func NewCategoryHandler(service service.CategoryServiceInterface) *CategoryHandl
 // @Success      201       {object}   CategoryResponse
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /categories/ [post]
@@ -47,18 +51,9 @@ func (h *CategoryHandler) PostCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.Label == "" {
-		utils.WriteJSONError(w, http.StatusBadRequest, "label is required")
-		return
-	}
-
-	category, err := h.service.Create(r.Context(), req.Label, userId)
+	category, err := h.service.Create(r.Context(), req.Label, req.Color, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -67,11 +62,17 @@ func (h *CategoryHandler) PostCategory(w http.ResponseWriter, r *http.Request) {
 
 // Get all categories
 // @Summary      Get all categories
-// @Description Retrieve all categories
+// @Description Retrieve all categories, ordered by label or by total spend
 // @Tags         categories
 // @Accept       json
 // @Produce      json
+// @Param        sort  query     string  false  "Sort order: 'spend_desc' orders by total outcome amount descending (defaults to label)"
+// @Param        from  query     string  false  "Start date of the spend window when sorting by spend (ISO 8601 format, defaults to all time)"
+// @Param        to    query     string  false  "End date of the spend window when sorting by spend (ISO 8601 format, defaults to all time)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        fields query   string  false  "Comma separated category fields to return (ex: id,label), defaults to all"
 // @Success      200       {array}   CategoryResponse
+// @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
@@ -83,13 +84,90 @@ func (h *CategoryHandler) GetAllCategories(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	categories, err := h.service.GetAll(r.Context(), userId)
+	fields, err := parseFields[CategoryResponse](r.URL.Query().Get("fields"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'fields' value: "+err.Error())
+		return
+	}
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var categories []domain.Category
+
+	switch r.URL.Query().Get("sort") {
+	case "":
+		if from != nil || to != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "date filters require 'sort=spend_desc'")
+			return
+		}
+		categories, err = h.service.GetAll(r.Context(), userId)
+	case "spend_desc":
+		categories, err = h.service.GetAllBySpend(r.Context(), from, to, userId)
+	default:
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'sort' value, use 'spend_desc'")
+		return
+	}
 	if err != nil {
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
+		return
+	}
+
+	categoriesResp := toCategoriesResponse(categories)
+
+	if fields != nil {
+		data, err := projectList(categoriesResp, fields)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.WriteJSON(w, http.StatusOK, data)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, toCategoriesResponse(categories))
+	utils.WriteJSON(w, http.StatusOK, categoriesResp)
+}
+
+// Get archive suggestions
+// @Summary      Get archive suggestions
+// @Description Get the categories worth archiving: never used, or whose most recent outcome is older than staleMonths months, never used ones first
+// @Tags         categories
+// @Produce      json
+// @Param        staleMonths query int false "Months without outcomes for a category to be stale (default 6)"
+// @Success      200       {array}   CategoryUsageResponse
+// @Failure      400       {object}   ErrorResponse  "Bad request error"
+// @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
+// @Failure      500       {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /categories/archive-suggestions [get]
+func (h *CategoryHandler) GetArchiveSuggestions(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	staleMonths := domain.DefaultStaleMonths
+	if v := r.URL.Query().Get("staleMonths"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid staleMonths, use a positive number of months")
+			return
+		}
+		staleMonths = parsed
+	}
+
+	suggestions, err := h.service.GetArchiveSuggestions(r.Context(), staleMonths, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, toCategoryUsagesResponse(suggestions))
 }
 
 // Get a category
@@ -99,10 +177,12 @@ func (h *CategoryHandler) GetAllCategories(w http.ResponseWriter, r *http.Reques
 // @Accept       json
 // @Produce      json
 // @Param 		id path int true "Category ID"
+// @Param        fields query   string  false  "Comma separated category fields to return (ex: id,label), defaults to all"
 // @Success      200       {object}   CategoryResponse
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
 // @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /categories/{id} [get]
@@ -113,6 +193,12 @@ func (h *CategoryHandler) GetCategoryById(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	fields, err := parseFields[CategoryResponse](r.URL.Query().Get("fields"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'fields' value: "+err.Error())
+		return
+	}
+
 	idStr := r.PathValue("id")
 
 	id, err := strconv.Atoi(idStr)
@@ -123,21 +209,153 @@ func (h *CategoryHandler) GetCategoryById(w http.ResponseWriter, r *http.Request
 
 	category, err := h.service.GetById(r.Context(), id, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		if error, ok := errors.AsType[*domain.EntityNotFoundError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
+		utils.WriteError(w, err)
+		return
+	}
+
+	categoryResp := toCategoryResponse(category)
+
+	if fields != nil {
+		projected, err := projectFields(categoryResp, fields)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteJSON(w, http.StatusOK, projected)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, categoryResp)
+}
+
+// Update a category
+// @Summary      Update a category
+// @Description  Update the label and/or color of a category, an empty color clearing it
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param 		 id path int true "Category ID"
+// @Param        category  body      PatchCategoryByIdRequest  true  "Category payload"
+// @Success      200       {object}   CategoryResponse
+// @Failure      400       {object}   ErrorResponse  "Bad request error"
+// @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
+// @Failure      500       {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /categories/{id} [patch]
+func (h *CategoryHandler) PatchCategoryById(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req PatchCategoryByIdRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	label := ""
+	if req.Label != nil {
+		label = *req.Label
+	}
+
+	category, err := h.service.PatchById(r.Context(), id, label, req.Color, userId)
+	if err != nil {
+		utils.WriteError(w, err)
 		return
 	}
 
 	utils.WriteJSON(w, http.StatusOK, toCategoryResponse(category))
 }
 
+// Clone a category
+// @Summary      Clone a category
+// @Description  Create a new category with the settings (color) of an existing one, without its outcomes
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param 		 id path int true "Category ID"
+// @Param        category  body      CloneCategoryRequest  false  "Clone payload"
+// @Success      201       {object}   CategoryResponse
+// @Failure      400       {object}   ErrorResponse  "Bad request error"
+// @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
+// @Failure      500       {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /categories/{id}/clone [post]
+func (h *CategoryHandler) CloneCategoryById(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	// The body is optional, an empty one keeping the default label
+	var req CloneCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	category, err := h.service.Clone(r.Context(), id, req.Label, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusCreated, toCategoryResponse(category))
+}
+
+// Ensure default categories
+// @Summary      Ensure default categories
+// @Description  Create the configured starter categories if the user has no category yet. Idempotent: once the user has categories nothing is created and they are returned with a 200.
+// @Tags         categories
+// @Produce      json
+// @Success      200       {array}   CategoryResponse
+// @Success      201       {array}   CategoryResponse
+// @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
+// @Failure      500       {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /categories/ensure-defaults [post]
+func (h *CategoryHandler) EnsureDefaultCategories(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	categories, created, err := h.service.EnsureDefaults(r.Context(), userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	utils.WriteJSON(w, status, toCategoriesResponse(categories))
+}
+
 // Delete a category
 // @Summary      Delete a category
 // @Description Delete a category by id
@@ -148,6 +366,7 @@ func (h *CategoryHandler) GetCategoryById(w http.ResponseWriter, r *http.Request
 // @Success      204       "No Content"
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /categories/{id} [delete]
@@ -168,11 +387,7 @@ func (h *CategoryHandler) DeleteCategoryById(w http.ResponseWriter, r *http.Requ
 
 	err = h.service.DeleteById(r.Context(), id, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -183,7 +398,20 @@ func toCategoryResponse(category *domain.Category) CategoryResponse {
 	return CategoryResponse{
 		ID:    category.ID,
 		Label: category.Label,
+		Color: category.Color,
+	}
+}
+
+func toCategoryUsagesResponse(usages []domain.CategoryUsage) []CategoryUsageResponse {
+	usagesResp := make([]CategoryUsageResponse, 0, len(usages))
+	for _, u := range usages {
+		usagesResp = append(usagesResp, CategoryUsageResponse{
+			CategoryResponse: toCategoryResponse(&u.Category),
+			LastUsedAt:       u.LastUsedAt,
+			OutcomeCount:     u.OutcomeCount,
+		})
 	}
+	return usagesResp
 }
 
 func toCategoriesResponse(categories []domain.Category) []CategoryResponse {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/kerhael/accounting/internal/auth"
 	"github.com/kerhael/accounting/internal/domain"
@@ -18,13 +19,13 @@ import (
 
 func TestCategoryHandler_PostCategory_Success(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	input := map[string]string{"label": "Food"}
 	body, _ := json.Marshal(input)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
-	mockService.On("Create", ctx, "Food", 123).Return(&domain.Category{
+	mockService.On("Create", ctx, "Food", "", 123).Return(&domain.Category{
 		ID:     1,
 		UserId: 123,
 		Label:  "Food",
@@ -52,7 +53,7 @@ func TestCategoryHandler_PostCategory_Success(t *testing.T) {
 
 func TestCategoryHandler_PostCategory_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodPost, "/categories/", nil)
 
@@ -71,13 +72,16 @@ func TestCategoryHandler_PostCategory_NoAuthContext(t *testing.T) {
 
 func TestCategoryHandler_PostCategory_InvalidLabel(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	input := map[string]string{"label": ""}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Create", ctx, "", "", 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("label is required")})
+
 	req := httptest.NewRequest(http.MethodPost, "/categories/", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
@@ -86,18 +90,21 @@ func TestCategoryHandler_PostCategory_InvalidLabel(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 }
 
 func TestCategoryHandler_PostCategory_MissingLabelField(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	input := map[string]any{}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Create", ctx, "", "", 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("label is required")})
+
 	req := httptest.NewRequest(http.MethodPost, "/categories/", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
@@ -106,12 +113,12 @@ func TestCategoryHandler_PostCategory_MissingLabelField(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 }
 
 func TestCategoryHandler_PostCategory_InvalidJSON(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	body := []byte(`{invalid json}`)
 	req := httptest.NewRequest(http.MethodPost, "/categories/", bytes.NewReader(body))
@@ -129,13 +136,13 @@ func TestCategoryHandler_PostCategory_InvalidJSON(t *testing.T) {
 
 func TestCategoryHandler_PostCategory_ServiceError(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	input := map[string]string{"label": "Travel"}
 	body, _ := json.Marshal(input)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
-	mockService.On("Create", ctx, "Travel", 123).Return(nil, errors.New("db failure"))
+	mockService.On("Create", ctx, "Travel", "", 123).Return(nil, errors.New("db failure"))
 
 	req := httptest.NewRequest(http.MethodPost, "/categories/", bytes.NewReader(body))
 	req = req.WithContext(ctx)
@@ -151,14 +158,14 @@ func TestCategoryHandler_PostCategory_ServiceError(t *testing.T) {
 
 func TestCategoryHandler_PostCategory_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	input := map[string]string{"label": "InvalidCategory"}
 	body, _ := json.Marshal(input)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	invalidEntityErr := &domain.InvalidEntityError{UnderlyingCause: errors.New("category already exists")}
-	mockService.On("Create", ctx, "InvalidCategory", 123).Return(nil, invalidEntityErr)
+	mockService.On("Create", ctx, "InvalidCategory", "", 123).Return(nil, invalidEntityErr)
 
 	req := httptest.NewRequest(http.MethodPost, "/categories/", bytes.NewReader(body))
 	req = req.WithContext(ctx)
@@ -169,12 +176,12 @@ func TestCategoryHandler_PostCategory_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 }
 
 func TestCategoryHandler_GetCategoryById_Success(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	expectedCategory := &domain.Category{
@@ -205,9 +212,45 @@ func TestCategoryHandler_GetCategoryById_Success(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestCategoryHandler_GetCategoryById_Fields(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetById", ctx, 1, 123).Return(&domain.Category{ID: 1, Label: "Food", Color: "#FF8800", UserId: 123}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/1?fields=label", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.GetCategoryById(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"label":"Food"}`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_GetCategoryById_UnknownField(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/categories/1?fields=id,amount", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.GetCategoryById(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetById")
+}
+
 func TestCategoryHandler_GetCategoryById_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/categories/1", nil)
 
@@ -226,7 +269,7 @@ func TestCategoryHandler_GetCategoryById_NoAuthContext(t *testing.T) {
 
 func TestCategoryHandler_GetCategoryById_InvalidId(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/categories/invalid", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -245,7 +288,7 @@ func TestCategoryHandler_GetCategoryById_InvalidId(t *testing.T) {
 
 func TestCategoryHandler_GetCategoryById_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	invalidEntityErr := &domain.InvalidEntityError{UnderlyingCause: errors.New("invalid category id")}
@@ -261,14 +304,14 @@ func TestCategoryHandler_GetCategoryById_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestCategoryHandler_GetCategoryById_EntityNotFoundError(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	entityNotFoundErr := &domain.EntityNotFoundError{UnderlyingCause: errors.New("category not found")}
@@ -291,7 +334,7 @@ func TestCategoryHandler_GetCategoryById_EntityNotFoundError(t *testing.T) {
 
 func TestCategoryHandler_GetCategoryById_ServiceError(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	serviceErr := errors.New("database connection failed")
@@ -314,7 +357,7 @@ func TestCategoryHandler_GetCategoryById_ServiceError(t *testing.T) {
 
 func TestCategoryHandler_DeleteCategoryById_Success(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	mockService.On("DeleteById", ctx, 1, 123).Return(nil)
@@ -336,7 +379,7 @@ func TestCategoryHandler_DeleteCategoryById_Success(t *testing.T) {
 
 func TestCategoryHandler_DeleteCategoryById_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodDelete, "/categories/1", nil)
 
@@ -355,7 +398,7 @@ func TestCategoryHandler_DeleteCategoryById_NoAuthContext(t *testing.T) {
 
 func TestCategoryHandler_DeleteCategoryById_InvalidId(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodDelete, "/categories/invalid", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -373,7 +416,7 @@ func TestCategoryHandler_DeleteCategoryById_InvalidId(t *testing.T) {
 
 func TestCategoryHandler_DeleteCategoryById_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	invalidEntityErr := &domain.InvalidEntityError{UnderlyingCause: errors.New("cannot delete category with existing transactions")}
@@ -389,14 +432,14 @@ func TestCategoryHandler_DeleteCategoryById_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestCategoryHandler_DeleteCategoryById_ServiceError(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	serviceErr := errors.New("database connection failed")
@@ -419,7 +462,7 @@ func TestCategoryHandler_DeleteCategoryById_ServiceError(t *testing.T) {
 
 func TestCategoryHandler_GetAllCategories_Success(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	expectedCategories := []domain.Category{
@@ -450,9 +493,171 @@ func TestCategoryHandler_GetAllCategories_Success(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestCategoryHandler_GetArchiveSuggestions_Success(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	lastUsed := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	mockService.On("GetArchiveSuggestions", ctx, 12, 123).Return([]domain.CategoryUsage{
+		{Category: domain.Category{ID: 2, Label: "Books"}},
+		{Category: domain.Category{ID: 1, Label: "Gym", Color: "#00FF00"}, LastUsedAt: &lastUsed, OutcomeCount: 4},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/archive-suggestions?staleMonths=12", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetArchiveSuggestions(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[
+		{"id": 2, "label": "Books", "lastUsedAt": null, "outcomeCount": 0},
+		{"id": 1, "label": "Gym", "color": "#00FF00", "lastUsedAt": "2025-03-14T12:00:00Z", "outcomeCount": 4}
+	]`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_GetArchiveSuggestions_DefaultStaleMonths(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetArchiveSuggestions", ctx, domain.DefaultStaleMonths, 123).Return([]domain.CategoryUsage{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/archive-suggestions", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetArchiveSuggestions(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[]`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_GetArchiveSuggestions_InvalidStaleMonths(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/categories/archive-suggestions?staleMonths=-1", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetArchiveSuggestions(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetArchiveSuggestions")
+}
+
+func TestCategoryHandler_GetArchiveSuggestions_ValidationError(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetArchiveSuggestions", ctx, 1200, 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("staleMonths is too large")})
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/archive-suggestions?staleMonths=1200", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetArchiveSuggestions(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_GetAllCategories_Fields(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetAll", ctx, 123).Return([]domain.Category{
+		{ID: 1, Label: "Food", Color: "#FF8800", UserId: 123},
+		{ID: 2, Label: "Rent", UserId: 123},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/?fields=id,color", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllCategories(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"id":1,"color":"#FF8800"},{"id":2}]`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_GetAllCategories_SortBySpend(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedCategories := []domain.Category{
+		{ID: 2, Label: "Rent"},
+		{ID: 1, Label: "Books"},
+	}
+	mockService.On("GetAllBySpend", ctx, &from, (*time.Time)(nil), 123).Return(expectedCategories, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/?sort=spend_desc&from=2026-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllCategories(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data []domain.Category
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCategories, data)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_GetAllCategories_InvalidSort(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/categories/?sort=label_asc", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllCategories(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetAll")
+	mockService.AssertNotCalled(t, "GetAllBySpend")
+}
+
+func TestCategoryHandler_GetAllCategories_DatesWithoutSort(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/categories/?from=2026-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllCategories(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetAll")
+}
+
 func TestCategoryHandler_GetAllCategories_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/categories/", nil)
 
@@ -471,7 +676,7 @@ func TestCategoryHandler_GetAllCategories_NoAuthContext(t *testing.T) {
 
 func TestCategoryHandler_GetAllCategories_ServiceError(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	serviceErr := errors.New("database connection failed")
@@ -493,7 +698,7 @@ func TestCategoryHandler_GetAllCategories_ServiceError(t *testing.T) {
 
 func TestCategoryHandler_GetAllCategories_EmptyList(t *testing.T) {
 	mockService := new(mocks.CategoryService)
-	handler := NewCategoryHandler(mockService)
+	handler := NewCategoryHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	expectedCategories := []domain.Category{}
@@ -519,3 +724,270 @@ func TestCategoryHandler_GetAllCategories_EmptyList(t *testing.T) {
 
 	mockService.AssertExpectations(t)
 }
+
+func TestCategoryHandler_PatchCategoryById_Success(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	body, _ := json.Marshal(map[string]string{"color": "#FF8800"})
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	color := "#FF8800"
+	mockService.On("PatchById", ctx, 1, "", &color, 123).Return(&domain.Category{
+		ID:     1,
+		UserId: 123,
+		Label:  "Food",
+		Color:  "#FF8800",
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/categories/1", bytes.NewReader(body))
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.PatchCategoryById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data CategoryResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, "#FF8800", data.Color)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_PatchCategoryById_ClearColor(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	body, _ := json.Marshal(map[string]string{"color": ""})
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	color := ""
+	mockService.On("PatchById", ctx, 1, "", &color, 123).Return(&domain.Category{
+		ID:     1,
+		UserId: 123,
+		Label:  "Food",
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/categories/1", bytes.NewReader(body))
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.PatchCategoryById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_PatchCategoryById_InvalidColor(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	body, _ := json.Marshal(map[string]string{"color": "orange"})
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	color := "orange"
+	mockService.On("PatchById", ctx, 1, "", &color, 123).Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("color must be a #RRGGBB hex string")})
+
+	req := httptest.NewRequest(http.MethodPatch, "/categories/1", bytes.NewReader(body))
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.PatchCategoryById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_PatchCategoryById_NotFound(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	body, _ := json.Marshal(map[string]string{"label": "Food"})
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("PatchById", ctx, 9, "Food", (*string)(nil), 123).Return(nil, &domain.EntityNotFoundError{UnderlyingCause: errors.New("not found")})
+
+	req := httptest.NewRequest(http.MethodPatch, "/categories/9", bytes.NewReader(body))
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "9")
+	w := httptest.NewRecorder()
+
+	handler.PatchCategoryById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_CloneCategoryById_Success(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	body, _ := json.Marshal(map[string]string{"label": "Food 2027"})
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Clone", ctx, 1, "Food 2027", 123).Return(&domain.Category{
+		ID:     2,
+		UserId: 123,
+		Label:  "Food 2027",
+		Color:  "#FF8800",
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/categories/1/clone", bytes.NewReader(body))
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.CloneCategoryById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var data CategoryResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, CategoryResponse{ID: 2, Label: "Food 2027", Color: "#FF8800"}, data)
+	assert.NotEqual(t, 1, data.ID)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_CloneCategoryById_EmptyBody(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Clone", ctx, 1, "", 123).Return(&domain.Category{
+		ID:     2,
+		UserId: 123,
+		Label:  "Food (copy)",
+		Color:  "#FF8800",
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/categories/1/clone", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.CloneCategoryById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_CloneCategoryById_DuplicateLabel(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	body, _ := json.Marshal(map[string]string{"label": "Groceries"})
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Clone", ctx, 1, "Groceries", 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("category already exists")})
+
+	req := httptest.NewRequest(http.MethodPost, "/categories/1/clone", bytes.NewReader(body))
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.CloneCategoryById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_CloneCategoryById_NotFound(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Clone", ctx, 9, "", 123).
+		Return(nil, &domain.EntityNotFoundError{UnderlyingCause: errors.New("not found")})
+
+	req := httptest.NewRequest(http.MethodPost, "/categories/9/clone", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "9")
+	w := httptest.NewRecorder()
+
+	handler.CloneCategoryById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestCategoryHandler_EnsureDefaultCategories_Created(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("EnsureDefaults", ctx, 123).Return([]domain.Category{
+		{ID: 1, UserId: 123, Label: "Groceries"},
+		{ID: 2, UserId: 123, Label: "Housing"},
+	}, true, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/categories/ensure-defaults", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.EnsureDefaultCategories(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var data []CategoryResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, []CategoryResponse{{ID: 1, Label: "Groceries"}, {ID: 2, Label: "Housing"}}, data)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestCategoryHandler_EnsureDefaultCategories_AlreadyHasCategories(t *testing.T) {
+	mockService := new(mocks.CategoryService)
+	handler := NewCategoryHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("EnsureDefaults", ctx, 123).Return([]domain.Category{{ID: 7, UserId: 123, Label: "Food"}}, false, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/categories/ensure-defaults", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.EnsureDefaultCategories(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,8 @@
+package v1
+
+import "time"
+
+type DateBoundsResponse struct {
+	Min time.Time `json:"min"` // Date of the earliest transaction
+	Max time.Time `json:"max"` // Date of the latest transaction
+}
@@ -3,3 +3,13 @@ package v1
 type ErrorResponse struct {
 	Message string `json:"message"`
 }
+
+type ItemErrorResponse struct {
+	Index   int    `json:"index"`   // Index of the failed item in the request
+	Message string `json:"message"` // Reason of the failure
+}
+
+type BulkErrorResponse struct {
+	Message string              `json:"message"`
+	Errors  []ItemErrorResponse `json:"errors"`
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// projectedPaginatedResponse is a paginated response whose items were reduced to
+// the fields requested with the fields query parameter.
+type projectedPaginatedResponse struct {
+	Data       []map[string]any   `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+}
+
+// parseFields parses a comma separated fields query value, such as "id,amount",
+// allowing only the JSON field names of the response type T. An empty value
+// selects every field and yields nil.
+func parseFields[T any](value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	allowed := jsonFieldNames(reflect.TypeFor[T]())
+	var fields []string
+	for field := range strings.SplitSeq(value, ",") {
+		field = strings.TrimSpace(field)
+		if !slices.Contains(allowed, field) {
+			return nil, fmt.Errorf("unknown field %q, use one of %s", field, strings.Join(allowed, ","))
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// jsonFieldNames lists the JSON names of the fields of a struct type, including
+// the fields of embedded structs.
+func jsonFieldNames(t reflect.Type) []string {
+	var names []string
+	for field := range t.Fields() {
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			names = append(names, jsonFieldNames(field.Type)...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// projectFields keeps only the given fields of a response.
+func projectFields(v any, fields []string) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]any
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	projected := make(map[string]any, len(fields))
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			projected[field] = value
+		}
+	}
+	return projected, nil
+}
+
+// projectList keeps only the given fields of each response of a list.
+func projectList[T any](items []T, fields []string) ([]map[string]any, error) {
+	projected := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		p, err := projectFields(item, fields)
+		if err != nil {
+			return nil, err
+		}
+		projected = append(projected, p)
+	}
+	return projected, nil
+}
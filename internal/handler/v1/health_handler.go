@@ -16,12 +16,27 @@ func NewHealthHandler(service *service.HealthService) *HealthHandler {
 	return &HealthHandler{service: service}
 }
 
+// Liveness check
+// @Summary      Liveness check
+// @Description Check the server is up, without checking dependencies nor requiring any secret
+// @Tags         health
+// @Produce      json
+// @Success      200 {string} string '{"server":"ok"}'
+// @Router       /livez [get]
+func (h *HealthHandler) Live(w http.ResponseWriter, r *http.Request) {
+	utils.WriteJSON(w, http.StatusOK, map[string]string{
+		"server": "ok",
+	})
+}
+
 // Health check
 // @Summary      Health check
-// @Description Check server and database connectivity
+// @Description Check server and database connectivity. When HEALTH_SECRET is configured, the X-Health-Secret header must hold it.
 // @Tags         health
 // @Produce      plain
+// @Param        X-Health-Secret header string false "Health check shared secret, when configured"
 // @Success      200 {string} string '{"db":"ok","server":"ok"}'
+// @Failure      401 {object} ErrorResponse "Unauthorized error"
 // @Failure      503 {string} string '{"db":"ko","server":"ok"}'
 // @Router       /health [get]
 func (h *HealthHandler) Check(w http.ResponseWriter, r *http.Request) {
@@ -5,9 +5,9 @@ import (
 )
 
 type CreateIncomeRequest struct {
-	Name      string    `json:"name"`      // Name of the income
-	CreatedAt time.Time `json:"createdAt"` // Date of the income (ex: "2026-01-01T00:00:00Z")
-	Amount    int       `json:"amount"`    // Amount in cents (ex: 1999 for 19.99€)
+	Name      string      `json:"name"`                           // Name of the income
+	CreatedAt RequestTime `json:"createdAt" swaggertype:"string"` // Date of the income (ex: "2026-01-01T00:00:00Z", in the server timezone without offset)
+	Amount    int         `json:"amount"`                         // Amount in cents (ex: 1999 for 19.99€)
 }
 
 type GetAllIncomeRequest struct {
@@ -28,7 +28,15 @@ type IncomeResponse struct {
 }
 
 type PatchIncomeByIdRequest struct {
-	Name      *string    `json:"name"`      // Name of the income (optional)
-	CreatedAt *time.Time `json:"createdAt"` // Date of the income (optional, ex: "2026-01-01T00:00:00Z")
-	Amount    *int       `json:"amount"`    // Amount in cents (optional, ex: 1999 for 19.99€)
+	Name      *string      `json:"name"`                           // Name of the income (optional)
+	CreatedAt *RequestTime `json:"createdAt" swaggertype:"string"` // Date of the income (optional, ex: "2026-01-01T00:00:00Z", in the server timezone without offset)
+	Amount    *int         `json:"amount"`                         // Amount in cents (optional, ex: 1999 for 19.99€)
+}
+
+type IncomeStatsResponse struct {
+	Count   int `json:"count"`   // Number of incomes in the window
+	Min     int `json:"min"`     // Smallest amount in cents
+	Max     int `json:"max"`     // Largest amount in cents
+	Average int `json:"average"` // Average amount in cents (rounded)
+	Median  int `json:"median"`  // Median amount in cents (rounded)
 }
@@ -2,7 +2,6 @@ package v1
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -15,11 +14,12 @@ import (
 )
 
 type IncomeHandler struct {
-	service service.IncomeServiceInterface
+	service  service.IncomeServiceInterface
+	location *time.Location
 }
 
-func NewIncomeHandler(service service.IncomeServiceInterface) *IncomeHandler {
-	return &IncomeHandler{service: service}
+func NewIncomeHandler(service service.IncomeServiceInterface, location *time.Location) *IncomeHandler {
+	return &IncomeHandler{service: service, location: location}
 }
 
 // Create an income
@@ -32,6 +32,7 @@ func NewIncomeHandler(service service.IncomeServiceInterface) *IncomeHandler {
 // @Success      201       {object}   IncomeResponse
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401   {object}       ErrorResponse  "Unauthorized error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /incomes/ [post]
@@ -49,26 +50,15 @@ func (h *IncomeHandler) PostIncome(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.Name == "" {
-		utils.WriteJSONError(w, http.StatusBadRequest, "name is required")
-		return
-	}
-	if req.Amount <= 0 {
-		utils.WriteJSONError(w, http.StatusBadRequest, "amount is required and must be positive")
-		return
-	}
 	if req.CreatedAt.IsZero() {
 		utils.WriteJSONError(w, http.StatusBadRequest, "creation date is required")
 		return
 	}
 
-	income, err := h.service.Create(r.Context(), req.Name, req.Amount, &req.CreatedAt, userId)
+	createdAt := req.CreatedAt.resolve(h.location)
+	income, err := h.service.Create(r.Context(), req.Name, req.Amount, &createdAt, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -83,8 +73,10 @@ func (h *IncomeHandler) PostIncome(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
 // @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
 // @Param        offset query    int     false  "Items offset (defaults to 0)"
 // @Param        limit query     int     false  "Items limit (defaults to 20, max 100)"
+// @Param        fields query   string  false  "Comma separated income fields to return (ex: id,amount), defaults to all"
 // @Success      200   {object}  PaginatedIncomesResponse
 // @Failure      400   {object}  ErrorResponse  "Bad request error"
 // @Failure      401   {object}  ErrorResponse  "Unauthorized error"
@@ -99,33 +91,24 @@ func (h *IncomeHandler) GetAllIncomes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var from, to *time.Time
+	fields, err := parseFields[IncomeResponse](r.URL.Query().Get("fields"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'fields' value: "+err.Error())
+		return
+	}
+
 	offset := domain.DefaultOffset
 	limit := domain.DefaultLimit
 
-	fromStr := r.URL.Query().Get("from")
-	if fromStr != "" {
-		parsedFrom, err := time.Parse(time.RFC3339, fromStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'from' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		from = &parsedFrom
-	}
-
-	toStr := r.URL.Query().Get("to")
-	if toStr != "" {
-		parsedTo, err := time.Parse(time.RFC3339, toStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'to' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		to = &parsedTo
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
 	// If no dates provided, default to current month
 	if from == nil && to == nil {
-		now := time.Now()
+		now := time.Now().In(h.location)
 		from = &time.Time{}
 		*from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
 		to = &now
@@ -157,22 +140,33 @@ func (h *IncomeHandler) GetAllIncomes(w http.ResponseWriter, r *http.Request) {
 
 	incomes, total, err := h.service.GetAll(r.Context(), from, to, userId, limit, offset)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidDateError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, PaginatedIncomesResponse{
+	resp := PaginatedIncomesResponse{
 		Data: toIncomesResponse(incomes),
 		Pagination: PaginationResponse{
 			Offset: offset,
 			Limit:  limit,
 			Total:  total,
 		},
-	})
+	}
+
+	if fields != nil {
+		data, err := projectList(resp.Data, fields)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.WriteJSON(w, http.StatusOK, projectedPaginatedResponse{
+			Data:       data,
+			Pagination: resp.Pagination,
+		})
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, resp)
 }
 
 // Get an income
@@ -182,10 +176,12 @@ func (h *IncomeHandler) GetAllIncomes(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param 		id path int true "Income ID"
+// @Param        fields query   string  false  "Comma separated income fields to return (ex: id,amount), defaults to all"
 // @Success      200       {object}   IncomeResponse
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}  ErrorResponse  "Unauthorized error"
 // @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /incomes/{id} [get]
@@ -196,6 +192,12 @@ func (h *IncomeHandler) GetIncomeById(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields, err := parseFields[IncomeResponse](r.URL.Query().Get("fields"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'fields' value: "+err.Error())
+		return
+	}
+
 	idStr := r.PathValue("id")
 
 	id, err := strconv.Atoi(idStr)
@@ -206,19 +208,23 @@ func (h *IncomeHandler) GetIncomeById(w http.ResponseWriter, r *http.Request) {
 
 	income, err := h.service.GetById(r.Context(), id, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		if error, ok := errors.AsType[*domain.EntityNotFoundError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
+		utils.WriteError(w, err)
+		return
+	}
+
+	incomeResp := toIncomeResponse(income)
+
+	if fields != nil {
+		projected, err := projectFields(incomeResp, fields)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteJSON(w, http.StatusOK, projected)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, toIncomeResponse(income))
+	utils.WriteJSON(w, http.StatusOK, incomeResp)
 }
 
 // Update an income
@@ -233,6 +239,7 @@ func (h *IncomeHandler) GetIncomeById(w http.ResponseWriter, r *http.Request) {
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
 // @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /incomes/{id} [patch]
@@ -264,22 +271,12 @@ func (h *IncomeHandler) PatchIncomeById(w http.ResponseWriter, r *http.Request)
 
 	amount := 0
 	if req.Amount != nil {
-		reqAmount := *req.Amount
-		if reqAmount <= 0 {
-			utils.WriteJSONError(w, http.StatusBadRequest, "amount must be positive")
-			return
-		}
-		amount = reqAmount
-
+		amount = *req.Amount
 	}
 
-	income, err := h.service.PatchById(r.Context(), id, name, amount, req.CreatedAt, userId)
+	income, err := h.service.PatchById(r.Context(), id, name, amount, resolveOptional(req.CreatedAt, h.location), userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.EntityNotFoundError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -296,6 +293,8 @@ func (h *IncomeHandler) PatchIncomeById(w http.ResponseWriter, r *http.Request)
 // @Success      204       "No Content"
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /incomes/{id} [delete]
@@ -316,15 +315,174 @@ func (h *IncomeHandler) DeleteIncomeById(w http.ResponseWriter, r *http.Request)
 
 	err = h.service.DeleteById(r.Context(), id, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Get largest incomes
+// @Summary      Get largest incomes
+// @Description  Retrieve the largest incomes between dates (defaults to current month if not provided)
+// @Tags         incomes
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        limit query     int     false  "Items limit (defaults to 5, max 100)"
+// @Param        allTime query   bool    false  "Aggregate over all time without date defaulting (cannot be combined with date filters)"
+// @Success      200   {array}   IncomeResponse
+// @Failure      400   {object}  ErrorResponse  "Bad request error"
+// @Failure      401   {object}  ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}  ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /incomes/largest [get]
+func (h *IncomeHandler) GetLargestIncomes(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	limit := domain.DefaultLargestLimit
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	allTime, err := parseAllTime(r, from, to)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// If no dates provided, default to current month
+	if from == nil && to == nil && !allTime {
+		now := time.Now().In(h.location)
+		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		from = &firstDayOfMonth
+		to = &now
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid limit")
 			return
 		}
+		if parsedLimit > domain.MaxLimit {
+			utils.WriteJSONError(w, http.StatusBadRequest, "limit must be less than or equal to 100")
+			return
+		}
+		limit = parsedLimit
+	}
+
+	incomes, err := h.service.GetLargest(r.Context(), from, to, userId, limit)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, toIncomesResponse(incomes))
+}
+
+// Get incomes statistics
+// @Summary      Get incomes statistics
+// @Description  Get the count, min, max, average and median amount of incomes between dates (defaults to current month if not provided)
+// @Tags         incomes
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        allTime query   bool    false  "Aggregate over all time without date defaulting (cannot be combined with date filters)"
+// @Success      200   {object}  IncomeStatsResponse
+// @Failure      400   {object}  ErrorResponse  "Bad request error"
+// @Failure      401   {object}  ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}  ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /incomes/stats [get]
+func (h *IncomeHandler) GetIncomesStats(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	allTime, err := parseAllTime(r, from, to)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// If no dates provided, default to current month
+	if from == nil && to == nil && !allTime {
+		now := time.Now().In(h.location)
+		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		from = &firstDayOfMonth
+		to = &now
+	}
+
+	stats, err := h.service.GetStats(r.Context(), from, to, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, IncomeStatsResponse{
+		Count:   stats.Count,
+		Min:     stats.Min,
+		Max:     stats.Max,
+		Average: stats.Average,
+		Median:  stats.Median,
+	})
+}
+
+// Get date bounds of incomes
+// @Summary      Get date bounds of incomes
+// @Description Get the dates of the earliest and latest incomes, or no content if there are none
+// @Tags         incomes
+// @Accept       json
+// @Produce      json
+// @Success      200   {object}   DateBoundsResponse
+// @Success      204   "No Content"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /incomes/date-bounds [get]
+func (h *IncomeHandler) GetIncomesDateBounds(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	bounds, err := h.service.GetDateBounds(r.Context(), userId)
+	if err != nil {
 		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	if bounds == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, DateBoundsResponse{
+		Min: bounds.Min,
+		Max: bounds.Max,
+	})
 }
 
 func toIncomeResponse(income *domain.Income) IncomeResponse {
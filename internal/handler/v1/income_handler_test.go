@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,13 +21,13 @@ import (
 
 func TestIncomeHandler_PostIncome_Success(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	input := CreateIncomeRequest{
 		Name:      "Salary",
 		Amount:    300000,
-		CreatedAt: createdAt,
+		CreatedAt: RequestTime{Time: createdAt},
 	}
 	body, _ := json.Marshal(input)
 
@@ -66,7 +67,7 @@ func TestIncomeHandler_PostIncome_Success(t *testing.T) {
 
 func TestOutcomeHandler_PostIncome_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodPost, "/incomes/", nil)
 
@@ -85,7 +86,7 @@ func TestOutcomeHandler_PostIncome_NoAuthContext(t *testing.T) {
 
 func TestIncomeHandler_PostIncome_InvalidJSON(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodPost, "/incomes/", bytes.NewReader([]byte("invalid json")))
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -102,16 +103,19 @@ func TestIncomeHandler_PostIncome_InvalidJSON(t *testing.T) {
 
 func TestIncomeHandler_PostIncome_MissingName(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	input := CreateIncomeRequest{
 		Amount:    300000,
-		CreatedAt: time.Now(),
+		CreatedAt: RequestTime{Time: time.Now()},
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Create", ctx, "", 300000, mock.Anything, 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("name cannot be empty")})
+
 	req := httptest.NewRequest(http.MethodPost, "/incomes/", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
@@ -120,25 +124,28 @@ func TestIncomeHandler_PostIncome_MissingName(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "name is required")
+	assert.Contains(t, string(bodyBytes), "name cannot be empty")
 }
 
 func TestIncomeHandler_PostIncome_InvalidAmount(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	input := CreateIncomeRequest{
 		Name:      "Salary",
 		Amount:    0,
-		CreatedAt: time.Now(),
+		CreatedAt: RequestTime{Time: time.Now()},
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Create", ctx, "Salary", 0, mock.Anything, 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("amount must be greater than zero")})
+
 	req := httptest.NewRequest(http.MethodPost, "/incomes/", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
@@ -147,25 +154,28 @@ func TestIncomeHandler_PostIncome_InvalidAmount(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "amount is required and must be positive")
+	assert.Contains(t, string(bodyBytes), "amount must be greater than zero")
 }
 
 func TestIncomeHandler_PostIncome_NegativeAmount(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	input := CreateIncomeRequest{
 		Name:      "Salary",
 		Amount:    -100,
-		CreatedAt: time.Now(),
+		CreatedAt: RequestTime{Time: time.Now()},
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Create", ctx, "Salary", -100, mock.Anything, 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("amount must be greater than zero")})
+
 	req := httptest.NewRequest(http.MethodPost, "/incomes/", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
@@ -174,20 +184,20 @@ func TestIncomeHandler_PostIncome_NegativeAmount(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "amount is required and must be positive")
+	assert.Contains(t, string(bodyBytes), "amount must be greater than zero")
 }
 
 func TestIncomeHandler_PostIncome_ZeroCreatedAt(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	input := CreateIncomeRequest{
 		Name:      "Salary",
 		Amount:    300000,
-		CreatedAt: time.Time{},
+		CreatedAt: RequestTime{Time: time.Time{}},
 	}
 	body, _ := json.Marshal(input)
 
@@ -209,13 +219,13 @@ func TestIncomeHandler_PostIncome_ZeroCreatedAt(t *testing.T) {
 
 func TestIncomeHandler_PostIncome_ServiceError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	createdAt := time.Now()
 	input := CreateIncomeRequest{
 		Name:      "Salary",
 		Amount:    300000,
-		CreatedAt: createdAt,
+		CreatedAt: RequestTime{Time: createdAt},
 	}
 	body, _ := json.Marshal(input)
 
@@ -233,20 +243,20 @@ func TestIncomeHandler_PostIncome_ServiceError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestIncomeHandler_PostIncome_InternalError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	createdAt := time.Now()
 	input := CreateIncomeRequest{
 		Name:      "Salary",
 		Amount:    300000,
-		CreatedAt: createdAt,
+		CreatedAt: RequestTime{Time: createdAt},
 	}
 	body, _ := json.Marshal(input)
 
@@ -271,7 +281,7 @@ func TestIncomeHandler_PostIncome_InternalError(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_Success(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	expectedIncomes := []domain.Income{
@@ -320,7 +330,7 @@ func TestIncomeHandler_GetAllIncomes_Success(t *testing.T) {
 
 func TestOutcomeHandler_GetAllIncomes_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/incomes/", nil)
 
@@ -339,7 +349,7 @@ func TestOutcomeHandler_GetAllIncomes_NoAuthContext(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_EmptyList(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -369,7 +379,7 @@ func TestIncomeHandler_GetAllIncomes_EmptyList(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_WithDateFilters(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -410,9 +420,46 @@ func TestIncomeHandler_GetAllIncomes_WithDateFilters(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestIncomeHandler_GetAllIncomes_WithMonth(t *testing.T) {
+	paris, _ := time.LoadLocation("Europe/Paris")
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, paris)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, paris)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, paris).Add(-time.Nanosecond)
+	mockService.On("GetAll", ctx, &from, &to, userId, 20, 0).Return([]domain.Income{}, 0, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/?month=2026-03", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllIncomes(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_GetAllIncomes_MonthWithFrom(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/incomes/?month=2026-03&from=2026-03-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllIncomes(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "'month' cannot be combined with 'from' or 'to'")
+	mockService.AssertNotCalled(t, "GetAll")
+}
+
 func TestIncomeHandler_GetAllIncomes_WithPagination(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -451,7 +498,7 @@ func TestIncomeHandler_GetAllIncomes_WithPagination(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_InvalidOffset(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/incomes/?offset=-1", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -467,7 +514,7 @@ func TestIncomeHandler_GetAllIncomes_InvalidOffset(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_InvalidLimit(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/incomes/?limit=101", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -483,7 +530,7 @@ func TestIncomeHandler_GetAllIncomes_InvalidLimit(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_BadFromAndToDates(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -507,7 +554,7 @@ func TestIncomeHandler_GetAllIncomes_BadFromAndToDates(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_InvalidFromDate(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/incomes/?from=invalid-date", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -527,7 +574,7 @@ func TestIncomeHandler_GetAllIncomes_InvalidFromDate(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_InvalidToDate(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/incomes/?to=invalid-date", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -547,7 +594,7 @@ func TestIncomeHandler_GetAllIncomes_InvalidToDate(t *testing.T) {
 
 func TestIncomeHandler_GetAllIncomes_ServiceError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -569,7 +616,7 @@ func TestIncomeHandler_GetAllIncomes_ServiceError(t *testing.T) {
 
 func TestIncomeHandler_GetIncomeById_Success(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -607,7 +654,7 @@ func TestIncomeHandler_GetIncomeById_Success(t *testing.T) {
 
 func TestOutcomeHandler_GetIncomeById_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/incomes/1", nil)
 
@@ -626,7 +673,7 @@ func TestOutcomeHandler_GetIncomeById_NoAuthContext(t *testing.T) {
 
 func TestIncomeHandler_GetIncomeById_InvalidId(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodGet, "/incomes/invalid", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -644,7 +691,7 @@ func TestIncomeHandler_GetIncomeById_InvalidId(t *testing.T) {
 
 func TestIncomeHandler_GetIncomeById_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -661,14 +708,14 @@ func TestIncomeHandler_GetIncomeById_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestIncomeHandler_GetIncomeById_EntityNotFoundError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -692,7 +739,7 @@ func TestIncomeHandler_GetIncomeById_EntityNotFoundError(t *testing.T) {
 
 func TestIncomeHandler_GetIncomeById_ServiceError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -716,7 +763,7 @@ func TestIncomeHandler_GetIncomeById_ServiceError(t *testing.T) {
 
 func TestIncomeHandler_PatchIncomeById_Success_NameOnly(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	name := "Updated Salary"
 	input := PatchIncomeByIdRequest{
@@ -758,9 +805,33 @@ func TestIncomeHandler_PatchIncomeById_Success_NameOnly(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestIncomeHandler_PostIncome_ZonelessCreatedAt(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	paris, _ := time.LoadLocation("Europe/Paris")
+	handler := NewIncomeHandler(mockService, paris)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	expected := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	mockService.On("Create", ctx, "Salary", 300000, mock.MatchedBy(func(t *time.Time) bool {
+		return t != nil && t.Equal(expected) && t.Location() == time.UTC
+	}), 123).Return(&domain.Income{ID: 1, Name: "Salary", Amount: 300000, CreatedAt: &expected}, nil)
+
+	body := `{"name":"Salary","amount":300000,"createdAt":"2026-01-01T09:00:00"}`
+	req := httptest.NewRequest(http.MethodPost, "/incomes/", strings.NewReader(body))
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.PostIncome(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"createdAt":"2026-01-01T08:00:00Z"`)
+
+	mockService.AssertExpectations(t)
+}
+
 func TestIncomeHandler_PatchIncomeById_Success_AllFields(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	name := "Updated Salary"
 	amount := 350000
@@ -769,7 +840,7 @@ func TestIncomeHandler_PatchIncomeById_Success_AllFields(t *testing.T) {
 	input := PatchIncomeByIdRequest{
 		Name:      &name,
 		Amount:    &amount,
-		CreatedAt: &newCreatedAt,
+		CreatedAt: &RequestTime{Time: newCreatedAt},
 	}
 	body, _ := json.Marshal(input)
 
@@ -810,7 +881,7 @@ func TestIncomeHandler_PatchIncomeById_Success_AllFields(t *testing.T) {
 
 func TestOutcomeHandler_PatchIncomeById_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodPatch, "/incomes/1", nil)
 
@@ -829,7 +900,7 @@ func TestOutcomeHandler_PatchIncomeById_NoAuthContext(t *testing.T) {
 
 func TestIncomeHandler_PatchIncomeById_InvalidJSON(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodPatch, "/incomes/1", bytes.NewReader([]byte("invalid json")))
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -847,7 +918,7 @@ func TestIncomeHandler_PatchIncomeById_InvalidJSON(t *testing.T) {
 
 func TestIncomeHandler_PatchIncomeById_InvalidId(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	name := "Updated Salary"
 	input := PatchIncomeByIdRequest{
@@ -874,7 +945,7 @@ func TestIncomeHandler_PatchIncomeById_InvalidId(t *testing.T) {
 
 func TestIncomeHandler_PatchIncomeById_NegativeAmount(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	amount := -100
 	input := PatchIncomeByIdRequest{
@@ -882,8 +953,11 @@ func TestIncomeHandler_PatchIncomeById_NegativeAmount(t *testing.T) {
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("PatchById", ctx, 1, "", -100, (*time.Time)(nil), 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("amount must be positive")})
+
 	req := httptest.NewRequest(http.MethodPatch, "/incomes/1", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	req.SetPathValue("id", "1")
 	w := httptest.NewRecorder()
@@ -893,7 +967,7 @@ func TestIncomeHandler_PatchIncomeById_NegativeAmount(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	assert.Contains(t, string(bodyBytes), "amount must be positive")
@@ -901,7 +975,7 @@ func TestIncomeHandler_PatchIncomeById_NegativeAmount(t *testing.T) {
 
 func TestIncomeHandler_PatchIncomeById_EntityNotFoundError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	name := "Updated Salary"
 	input := PatchIncomeByIdRequest{
@@ -931,7 +1005,7 @@ func TestIncomeHandler_PatchIncomeById_EntityNotFoundError(t *testing.T) {
 
 func TestIncomeHandler_PatchIncomeById_ServiceError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	name := "Updated Salary"
 	input := PatchIncomeByIdRequest{
@@ -960,7 +1034,7 @@ func TestIncomeHandler_PatchIncomeById_ServiceError(t *testing.T) {
 
 func TestIncomeHandler_DeleteIncomeById_Success(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -983,7 +1057,7 @@ func TestIncomeHandler_DeleteIncomeById_Success(t *testing.T) {
 
 func TestOutcomeHandler_DeleteIncomeById_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodDelete, "/incomes/1", nil)
 
@@ -1002,7 +1076,7 @@ func TestOutcomeHandler_DeleteIncomeById_NoAuthContext(t *testing.T) {
 
 func TestIncomeHandler_DeleteIncomeById_InvalidId(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	req := httptest.NewRequest(http.MethodDelete, "/incomes/invalid", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -1025,7 +1099,7 @@ func TestIncomeHandler_DeleteIncomeById_InvalidId(t *testing.T) {
 
 func TestIncomeHandler_DeleteIncomeById_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	invalidEntityErr := &domain.InvalidEntityError{UnderlyingCause: errors.New("invalid income id")}
@@ -1041,14 +1115,36 @@ func TestIncomeHandler_DeleteIncomeById_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_DeleteIncomeById_NotFound(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("DeleteById", ctx, 99, 123).Return(&domain.EntityNotFoundError{UnderlyingCause: errors.New("not found")})
+
+	req := httptest.NewRequest(http.MethodDelete, "/incomes/99", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "99")
+	w := httptest.NewRecorder()
+
+	handler.DeleteIncomeById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestIncomeHandler_DeleteIncomeById_ServiceError(t *testing.T) {
 	mockService := new(mocks.IncomeService)
-	handler := NewIncomeHandler(mockService)
+	handler := NewIncomeHandler(mockService, time.UTC)
 
 	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
 	mockService.On("DeleteById", ctx, 1, 123).Return(assert.AnError)
@@ -1067,3 +1163,218 @@ func TestIncomeHandler_DeleteIncomeById_ServiceError(t *testing.T) {
 
 	mockService.AssertExpectations(t)
 }
+
+func TestIncomeHandler_GetLargestIncomes_Success(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	expectedIncomes := []domain.Income{
+		{ID: 2, Name: "Salary", Amount: 250000, CreatedAt: &time.Time{}, UserId: userId},
+		{ID: 1, Name: "Bonus", Amount: 50000, CreatedAt: &time.Time{}, UserId: userId},
+	}
+	mockService.On("GetLargest", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId, 2).Return(expectedIncomes, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/largest?limit=2", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetLargestIncomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data []IncomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Len(t, data, 2)
+	assert.Equal(t, 250000, data[0].Amount)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_GetLargestIncomes_InvalidLimit(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/largest?limit=0", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.GetLargestIncomes(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetLargest")
+}
+
+func TestIncomeHandler_GetIncomesStats_Success(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetStats", ctx, &from, &to, userId).Return(&domain.AmountStats{
+		Count: 4, Min: 1000, Max: 9000, Average: 4000, Median: 3000,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/stats?from=2026-01-01T00:00:00Z&to=2026-02-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetIncomesStats(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"count":4,"min":1000,"max":9000,"average":4000,"median":3000}`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_GetIncomesStats_InvalidDateError(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetStats", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).
+		Return(nil, &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")})
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/stats?from=2026-02-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetIncomesStats(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_GetIncomesDateBounds_Success(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	minDate := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2026, 9, 30, 18, 30, 0, 0, time.UTC)
+	mockService.On("GetDateBounds", ctx, userId).Return(&domain.DateBounds{Min: minDate, Max: maxDate}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/date-bounds", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetIncomesDateBounds(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data DateBoundsResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.True(t, minDate.Equal(data.Min))
+	assert.True(t, maxDate.Equal(data.Max))
+
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_GetIncomesDateBounds_NoContent(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetDateBounds", ctx, userId).Return(nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/date-bounds", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetIncomesDateBounds(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_GetIncomesStats_AllTime(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetStats", ctx, (*time.Time)(nil), (*time.Time)(nil), userId).Return(&domain.AmountStats{Count: 40, Min: 1000, Max: 300000, Average: 150000, Median: 150000}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/stats?allTime=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetIncomesStats(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data IncomeStatsResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, 40, data.Count)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_GetAllIncomes_Fields(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetAll", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId, 20, 0).Return([]domain.Income{
+		{ID: 1, Name: "Salary", Amount: 300000, CreatedAt: &time.Time{}, UserId: userId},
+	}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/incomes/?fields=name", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllIncomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data struct {
+		Data []map[string]any `json:"data"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"name": "Salary"}}, data.Data)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestIncomeHandler_GetIncomeById_UnknownField(t *testing.T) {
+	mockService := new(mocks.IncomeService)
+	handler := NewIncomeHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/incomes/1?fields=id,categoryId", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.GetIncomeById(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetById")
+}
@@ -0,0 +1,7 @@
+package v1
+
+type OnboardingResponse struct {
+	HasCategories bool `json:"hasCategories"` // Whether the user created at least one category
+	HasIncome     bool `json:"hasIncome"`     // Whether the user recorded at least one income
+	HasOutcome    bool `json:"hasOutcome"`    // Whether the user recorded at least one outcome
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/handler/utils"
+	"github.com/kerhael/accounting/internal/service"
+)
+
+type OnboardingHandler struct {
+	service service.OnboardingServiceInterface
+}
+
+func NewOnboardingHandler(service service.OnboardingServiceInterface) *OnboardingHandler {
+	return &OnboardingHandler{service: service}
+}
+
+// Get onboarding status
+// @Summary      Get onboarding status
+// @Description Retrieve whether the authenticated user already has categories, incomes and outcomes
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Success      200       {object}   OnboardingResponse
+// @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500       {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /users/me/onboarding [get]
+func (h *OnboardingHandler) GetOnboardingStatus(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	status, err := h.service.GetStatus(r.Context(), userId)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, OnboardingResponse{
+		HasCategories: status.HasCategories,
+		HasIncome:     status.HasIncome,
+		HasOutcome:    status.HasOutcome,
+	})
+}
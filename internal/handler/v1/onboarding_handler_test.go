@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnboardingHandler_GetOnboardingStatus_Success(t *testing.T) {
+	mockService := new(mocks.OnboardingService)
+	handler := NewOnboardingHandler(mockService)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetStatus", ctx, 123).Return(&domain.OnboardingStatus{
+		HasCategories: true,
+		HasIncome:     false,
+		HasOutcome:    true,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/onboarding", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOnboardingStatus(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data OnboardingResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.True(t, data.HasCategories)
+	assert.False(t, data.HasIncome)
+	assert.True(t, data.HasOutcome)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOnboardingHandler_GetOnboardingStatus_NewUser(t *testing.T) {
+	mockService := new(mocks.OnboardingService)
+	handler := NewOnboardingHandler(mockService)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetStatus", ctx, 123).Return(&domain.OnboardingStatus{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/onboarding", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOnboardingStatus(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"hasCategories":false,"hasIncome":false,"hasOutcome":false}`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOnboardingHandler_GetOnboardingStatus_NoAuthContext(t *testing.T) {
+	mockService := new(mocks.OnboardingService)
+	handler := NewOnboardingHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/onboarding", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetOnboardingStatus(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockService.AssertNotCalled(t, "GetStatus")
+}
+
+func TestOnboardingHandler_GetOnboardingStatus_ServiceError(t *testing.T) {
+	mockService := new(mocks.OnboardingService)
+	handler := NewOnboardingHandler(mockService)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetStatus", ctx, 123).Return(nil, errors.New("db error"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/onboarding", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOnboardingStatus(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	mockService.AssertExpectations(t)
+}
@@ -5,10 +5,10 @@ import (
 )
 
 type CreateOutcomeRequest struct {
-	Name       string    `json:"name"`       // Name of the expense
-	CreatedAt  time.Time `json:"createdAt"`  // Date of the expense (ex: "2026-01-01T00:00:00Z")
-	Amount     int       `json:"amount"`     // Amount in cents (ex: 1999 for 19.99€)
-	CategoryId int       `json:"categoryId"` // ID of the associated category
+	Name       string      `json:"name"`                           // Name of the expense
+	CreatedAt  RequestTime `json:"createdAt" swaggertype:"string"` // Date of the expense (ex: "2026-01-01T00:00:00Z", in the server timezone without offset)
+	Amount     int         `json:"amount"`                         // Amount in cents (ex: 1999 for 19.99€)
+	CategoryId int         `json:"categoryId"`                     // ID of the associated category (defaults to the user's default category)
 }
 
 type GetAllOutcomeRequest struct {
@@ -20,6 +20,7 @@ type GetAllOutcomeRequest struct {
 type PaginatedOutcomesResponse struct {
 	Data       []OutcomeResponse  `json:"data"`
 	Pagination PaginationResponse `json:"pagination"`
+	NextCursor string             `json:"nextCursor,omitempty"` // Value of the after parameter fetching the next page (omitted on the last page)
 }
 
 type OutcomeResponse struct {
@@ -31,19 +32,102 @@ type OutcomeResponse struct {
 }
 
 type PatchOutcomeByIdRequest struct {
-	Name       *string    `json:"name"`       // Name of the expense (optional)
-	CreatedAt  *time.Time `json:"createdAt"`  // Date of the expense (optional, ex: "2026-01-01T00:00:00Z")
-	Amount     *int       `json:"amount"`     // Amount in cents (optional, ex: 1999 for 19.99€)
-	CategoryId *int       `json:"categoryId"` // ID of the associated category (optional)
+	Name       *string      `json:"name"`                           // Name of the expense (optional)
+	CreatedAt  *RequestTime `json:"createdAt" swaggertype:"string"` // Date of the expense (optional, ex: "2026-01-01T00:00:00Z", in the server timezone without offset)
+	Amount     *int         `json:"amount"`                         // Amount in cents (optional, ex: 1999 for 19.99€)
+	CategoryId *int         `json:"categoryId"`                     // ID of the associated category (optional)
+}
+
+type BulkPatchOutcomeRequest struct {
+	ID int `json:"id"` // ID of the expense to update
+	PatchOutcomeByIdRequest
 }
 
 type CategorySumResponse struct {
-	CategoryId int `json:"categoryId"` // Category ID
-	Total      int `json:"total"`      // Total amount in cents for this category
+	CategoryId int  `json:"categoryId"`      // Category ID
+	Total      int  `json:"total"`           // Total amount in cents for this category
+	Count      *int `json:"count,omitempty"` // Number of outcomes for this category, only with withCount=true
 }
 
 type SumOutcomeResponse []CategorySumResponse
 
+type CategoryAverageResponse struct {
+	CategoryId int `json:"categoryId"` // Category ID
+	Average    int `json:"avg"`        // Average outcome amount in cents for this category
+	Count      int `json:"count"`      // Number of outcomes for this category
+}
+
+type AverageOutcomeResponse []CategoryAverageResponse
+
+type OutlierOutcomeResponse struct {
+	OutcomeResponse
+	CategoryAverage int `json:"categoryAvg"` // Average outcome amount in cents of the category over the window
+}
+
+type HourlySumResponse struct {
+	Hour  int `json:"hour"`  // Hour of the day (0-23)
+	Total int `json:"total"` // Total amount in cents spent during this hour
+}
+
+type ByHourOutcomeResponse []HourlySumResponse
+
+type PeriodSumResponse struct {
+	Start string `json:"start"` // First day of the period (YYYY-MM-DD)
+	Total int    `json:"total"` // Total amount in cents spent during this period
+	Count int    `json:"count"` // Number of outcomes during this period
+}
+
+type GroupedOutcomeResponse []PeriodSumResponse
+
+type FrequentNameResponse struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"` // Number of outcomes with this name
+	Total int    `json:"total"` // Total amount in cents of these outcomes
+}
+
+type AmountBucketResponse struct {
+	Min   int  `json:"min"`   // Lower bound in cents, included
+	Max   *int `json:"max"`   // Upper bound in cents, excluded (null for the last bucket)
+	Count int  `json:"count"` // Number of outcomes in the bucket
+	Total int  `json:"total"` // Total amount in cents of the outcomes in the bucket
+}
+
+type HistogramOutcomeResponse []AmountBucketResponse
+
+type StreakResponse struct {
+	Days int    `json:"days"`           // Length of the streak in days
+	From string `json:"from,omitempty"` // First day of the streak (YYYY-MM-DD, omitted when empty)
+	To   string `json:"to,omitempty"`   // Last day of the streak (YYYY-MM-DD, omitted when empty)
+}
+
+type StreaksOutcomeResponse struct {
+	LongestSpending StreakResponse `json:"longestSpending"` // Longest run of days with at least one outcome
+	LongestNoSpend  StreakResponse `json:"longestNoSpend"`  // Longest run of days without any outcome
+}
+
+type RollingOutcomeResponse struct {
+	Window string    `json:"window"` // Trailing window (ex: "30d")
+	From   time.Time `json:"from"`   // Start of the window
+	To     time.Time `json:"to"`     // End of the window (now)
+	Total  int       `json:"total"`  // Total amount in cents over the window
+}
+
+type ProjectionOutcomeResponse struct {
+	SpentSoFar     int `json:"spentSoFar"`     // Amount in cents spent since the start of the month
+	DaysElapsed    int `json:"daysElapsed"`    // Number of days elapsed in the month, today included
+	DaysInMonth    int `json:"daysInMonth"`    // Number of days in the month
+	ProjectedTotal int `json:"projectedTotal"` // Projected month-end total in cents
+}
+
+type YearOverYearOutcomeResponse struct {
+	Month         string   `json:"month"`         // Compared month (ex: "2026-03")
+	Total         int      `json:"total"`         // Total amount in cents of the month
+	PreviousMonth string   `json:"previousMonth"` // Same month a year earlier (ex: "2025-03")
+	PreviousTotal int      `json:"previousTotal"` // Total amount in cents of the previous year's month
+	Delta         int      `json:"delta"`         // Total minus previous total, in cents
+	Percent       *float64 `json:"percent"`       // Delta as a percentage of the previous total, null without previous spending
+}
+
 type TotalOutcomeResponse struct {
 	Total int `json:"total"` // Total amount in cents
 }
@@ -61,3 +145,18 @@ type MonthlyTotalSeries struct {
 }
 
 type TotalSeriesOutcomeResponse []MonthlyTotalSeries
+
+type MonthlyCount struct {
+	Month string `json:"month"` // Month in YYYY-MM format
+	Count int    `json:"count"` // Number of outcomes
+}
+
+type MonthlyCountsOutcomeResponse []MonthlyCount
+
+type MonthlyForecast struct {
+	Month      string      `json:"month"`      // Forecast month in YYYY-MM format
+	Categories map[int]int `json:"categories"` // Map of categoryId to forecast amount in cents
+	Total      int         `json:"total"`      // Forecast total amount in cents
+}
+
+type ForecastOutcomeResponse []MonthlyForecast
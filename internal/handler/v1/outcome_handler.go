@@ -3,6 +3,7 @@ package v1
 import (
 	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -12,14 +13,17 @@ import (
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/kerhael/accounting/internal/handler/utils"
 	"github.com/kerhael/accounting/internal/service"
+	"github.com/kerhael/accounting/pkg/xlsx"
 )
 
 type OutcomeHandler struct {
-	service service.OutcomeServiceInterface
+	service      service.OutcomeServiceInterface
+	location     *time.Location
+	maxBulkItems int // Items accepted by bulk requests, 0 for unlimited
 }
 
-func NewOutcomeHandler(service service.OutcomeServiceInterface) *OutcomeHandler {
-	return &OutcomeHandler{service: service}
+func NewOutcomeHandler(service service.OutcomeServiceInterface, location *time.Location, maxBulkItems int) *OutcomeHandler {
+	return &OutcomeHandler{service: service, location: location, maxBulkItems: maxBulkItems}
 }
 
 // Create an outcome
@@ -32,6 +36,7 @@ func NewOutcomeHandler(service service.OutcomeServiceInterface) *OutcomeHandler
 // @Success      201       {object}   OutcomeResponse
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /outcomes/ [post]
@@ -49,30 +54,15 @@ func (h *OutcomeHandler) PostOutcome(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.Name == "" {
-		utils.WriteJSONError(w, http.StatusBadRequest, "name is required")
-		return
-	}
-	if req.Amount <= 0 {
-		utils.WriteJSONError(w, http.StatusBadRequest, "amount is required and must be positive")
-		return
-	}
-	if req.CategoryId == 0 {
-		utils.WriteJSONError(w, http.StatusBadRequest, "category is required")
-		return
-	}
 	if req.CreatedAt.IsZero() {
 		utils.WriteJSONError(w, http.StatusBadRequest, "creation date is required")
 		return
 	}
 
-	outcome, err := h.service.Create(r.Context(), req.Name, req.Amount, req.CategoryId, &req.CreatedAt, userId)
+	createdAt := req.CreatedAt.resolve(h.location)
+	outcome, err := h.service.Create(r.Context(), req.Name, req.Amount, req.CategoryId, &createdAt, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -87,13 +77,18 @@ func (h *OutcomeHandler) PostOutcome(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
 // @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
-// @Param        categoryId    query    int false "Category ID filter"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        categoryId    query    int false "Category ID filter (must be positive when provided)"
 // @Param        offset query    int     false  "Items offset (defaults to 0)"
 // @Param        limit query     int     false  "Items limit (defaults to 20, max 100)"
+// @Param        after query     string  false  "Cursor of the previous page (<createdAt>,<id>, as returned in nextCursor), cannot be combined with offset"
+// @Param        signed query   bool    false  "Serialize outcome amounts as negative numbers (defaults to false)"
+// @Param        fields query   string  false  "Comma separated outcome fields to return (ex: id,amount), defaults to all"
 // @Success      200   {object}  PaginatedOutcomesResponse
 // @Failure      400   {object}  ErrorResponse  "Bad request error"
 // @Failure      401   {object}   ErrorResponse  "Unauthorized error"
 // @Failure      404   {object}  ErrorResponse  "Not found error"
+// @Failure      422   {object}  ErrorResponse  "Validation error"
 // @Failure      500   {object}  ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /outcomes/ [get]
@@ -104,35 +99,33 @@ func (h *OutcomeHandler) GetAllOutcomes(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var from, to *time.Time
+	signed, err := parseBoolParam(r.URL.Query().Get("signed"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'signed' value, use true or false")
+		return
+	}
+
+	fields, err := parseFields[OutcomeResponse](r.URL.Query().Get("fields"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'fields' value: "+err.Error())
+		return
+	}
+
 	var categoryId int
+	var after *domain.Cursor
 	offset := domain.DefaultOffset
 	limit := domain.DefaultLimit
 
-	fromStr := r.URL.Query().Get("from")
-	if fromStr != "" {
-		parsedFrom, err := time.Parse(time.RFC3339, fromStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'from' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		from = &parsedFrom
-	}
-
-	toStr := r.URL.Query().Get("to")
-	if toStr != "" {
-		parsedTo, err := time.Parse(time.RFC3339, toStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'to' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		to = &parsedTo
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
 	categoryIdStr := r.URL.Query().Get("categoryId")
 	if categoryIdStr != "" {
 		categoryIdInt, err := strconv.Atoi(categoryIdStr)
-		if err != nil {
+		if err != nil || categoryIdInt <= 0 {
 			utils.WriteJSONError(w, http.StatusBadRequest, "invalid category")
 			return
 		}
@@ -149,6 +142,20 @@ func (h *OutcomeHandler) GetAllOutcomes(w http.ResponseWriter, r *http.Request)
 		offset = parsedOffset
 	}
 
+	afterStr := r.URL.Query().Get("after")
+	if afterStr != "" {
+		if offsetStr != "" {
+			utils.WriteJSONError(w, http.StatusBadRequest, "'after' cannot be combined with 'offset'")
+			return
+		}
+		parsedAfter, err := parseCursor(afterStr)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'after' cursor, use <createdAt>,<id>")
+			return
+		}
+		after = parsedAfter
+	}
+
 	limitStr := r.URL.Query().Get("limit")
 	if limitStr != "" {
 		parsedLimit, err := strconv.Atoi(limitStr)
@@ -165,34 +172,56 @@ func (h *OutcomeHandler) GetAllOutcomes(w http.ResponseWriter, r *http.Request)
 
 	// If no dates provided, default to current month
 	if from == nil && to == nil {
-		now := time.Now()
+		now := time.Now().In(h.location)
 		from = &time.Time{}
 		*from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
 		to = &now
 	}
 
-	outcomes, total, err := h.service.GetAll(r.Context(), from, to, categoryId, userId, limit, offset)
-	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidDateError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
-			return
+	var outcomes []domain.Outcome
+	var next *domain.Cursor
+	var total int
+	if after != nil {
+		outcomes, next, total, err = h.service.GetAllAfter(r.Context(), from, to, categoryId, userId, after, limit)
+	} else {
+		outcomes, total, err = h.service.GetAll(r.Context(), from, to, categoryId, userId, limit, offset)
+		if err == nil && len(outcomes) > 0 && offset+len(outcomes) < total {
+			last := outcomes[len(outcomes)-1]
+			next = &domain.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}
 		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+	}
+	if err != nil {
+		utils.WriteError(w, err)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, PaginatedOutcomesResponse{
-		Data: toOutcomesResponse(outcomes),
+	resp := PaginatedOutcomesResponse{
+		Data: signOutcomesResponse(toOutcomesResponse(outcomes), signed),
 		Pagination: PaginationResponse{
 			Offset: offset,
 			Limit:  limit,
 			Total:  total,
 		},
-	})
+	}
+	if next != nil {
+		resp.NextCursor = formatCursor(next)
+	}
+
+	if fields != nil {
+		data, err := projectList(resp.Data, fields)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.WriteJSON(w, http.StatusOK, projectedPaginatedResponse{
+			Data:       data,
+			Pagination: resp.Pagination,
+			NextCursor: resp.NextCursor,
+		})
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, resp)
 }
 
 // Get an outcome
@@ -202,10 +231,13 @@ func (h *OutcomeHandler) GetAllOutcomes(w http.ResponseWriter, r *http.Request)
 // @Accept       json
 // @Produce      json
 // @Param 		id path int true "Outcome ID"
+// @Param        signed query   bool    false  "Serialize outcome amounts as negative numbers (defaults to false)"
+// @Param        fields query   string  false  "Comma separated outcome fields to return (ex: id,amount), defaults to all"
 // @Success      200       {object}   OutcomeResponse
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
 // @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /outcomes/{id} [get]
@@ -216,6 +248,18 @@ func (h *OutcomeHandler) GetOutcomeById(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	signed, err := parseBoolParam(r.URL.Query().Get("signed"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'signed' value, use true or false")
+		return
+	}
+
+	fields, err := parseFields[OutcomeResponse](r.URL.Query().Get("fields"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'fields' value: "+err.Error())
+		return
+	}
+
 	idStr := r.PathValue("id")
 
 	id, err := strconv.Atoi(idStr)
@@ -226,19 +270,24 @@ func (h *OutcomeHandler) GetOutcomeById(w http.ResponseWriter, r *http.Request)
 
 	outcome, err := h.service.GetById(r.Context(), id, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		if error, ok := errors.AsType[*domain.EntityNotFoundError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
+		utils.WriteError(w, err)
+		return
+	}
+
+	outcomeResp := toOutcomeResponse(outcome)
+	outcomeResp.Amount = signedOutcomeAmount(outcomeResp.Amount, signed)
+
+	if fields != nil {
+		projected, err := projectFields(outcomeResp, fields)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteJSON(w, http.StatusOK, projected)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, toOutcomeResponse(outcome))
+	utils.WriteJSON(w, http.StatusOK, outcomeResp)
 }
 
 // Update an outcome
@@ -253,6 +302,7 @@ func (h *OutcomeHandler) GetOutcomeById(w http.ResponseWriter, r *http.Request)
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
 // @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /outcomes/{id} [patch]
@@ -284,13 +334,7 @@ func (h *OutcomeHandler) PatchOutcomeById(w http.ResponseWriter, r *http.Request
 
 	amount := 0
 	if req.Amount != nil {
-		reqAmount := *req.Amount
-		if reqAmount < 0 {
-			utils.WriteJSONError(w, http.StatusBadRequest, "amount must be positive")
-			return
-		}
-		amount = reqAmount
-
+		amount = *req.Amount
 	}
 
 	categoryId := 0
@@ -303,21 +347,80 @@ func (h *OutcomeHandler) PatchOutcomeById(w http.ResponseWriter, r *http.Request
 		categoryId = reqCategoryId
 	}
 
-	outcome, err := h.service.PatchById(r.Context(), id, name, amount, categoryId, req.CreatedAt, userId)
+	outcome, err := h.service.PatchById(r.Context(), id, name, amount, categoryId, resolveOptional(req.CreatedAt, h.location), userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, toOutcomeResponse(outcome))
+}
+
+// Update several outcomes
+// @Summary      Update several outcomes
+// @Description  Update several outcomes at once in a single transaction. If any item fails, nothing is updated and the failures are listed by index. Arrays longer than MAX_BULK_ITEMS are rejected
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        outcomes  body      []BulkPatchOutcomeRequest  true  "Outcome patches"
+// @Success      200       {array}    OutcomeResponse
+// @Failure      400       {object}   ErrorResponse  "Bad request error"
+// @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422       {object}   BulkErrorResponse  "Validation error"
+// @Failure      500       {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/bulk [patch]
+func (h *OutcomeHandler) BulkPatchOutcomes(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	req, err := decodeBulk[BulkPatchOutcomeRequest](r.Body, h.maxBulkItems)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(req) == 0 {
+		utils.WriteJSONError(w, http.StatusBadRequest, "at least one outcome is required")
+		return
+	}
+
+	patches := make([]domain.OutcomePatch, 0, len(req))
+	for _, item := range req {
+		patch := domain.OutcomePatch{
+			ID:        item.ID,
+			CreatedAt: resolveOptional(item.CreatedAt, h.location),
+		}
+		if item.Name != nil {
+			patch.Name = strings.TrimSpace(*item.Name)
+		}
+		if item.Amount != nil {
+			patch.Amount = *item.Amount
 		}
-		if error, ok := errors.AsType[*domain.EntityNotFoundError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
+		if item.CategoryId != nil {
+			patch.CategoryId = *item.CategoryId
+		}
+		patches = append(patches, patch)
+	}
+
+	outcomes, err := h.service.BulkPatch(r.Context(), patches, userId)
+	if err != nil {
+		if bulkErr, ok := errors.AsType[*domain.BulkError](err); ok {
+			resp := BulkErrorResponse{Message: bulkErr.Error()}
+			for _, e := range bulkErr.Errors {
+				resp.Errors = append(resp.Errors, ItemErrorResponse{Index: e.Index, Message: e.Err.Error()})
+			}
+			utils.WriteJSON(w, http.StatusUnprocessableEntity, resp)
 			return
 		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, toOutcomeResponse(outcome))
+	utils.WriteJSON(w, http.StatusOK, toOutcomesResponse(outcomes))
 }
 
 // Delete an outcome
@@ -330,6 +433,8 @@ func (h *OutcomeHandler) PatchOutcomeById(w http.ResponseWriter, r *http.Request
 // @Success      204       "No Content"
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /outcomes/{id} [delete]
@@ -350,11 +455,7 @@ func (h *OutcomeHandler) DeleteOutcomeById(w http.ResponseWriter, r *http.Reques
 
 	err = h.service.DeleteById(r.Context(), id, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -363,17 +464,22 @@ func (h *OutcomeHandler) DeleteOutcomeById(w http.ResponseWriter, r *http.Reques
 
 // Get sum of outcomes by category
 // @Summary      Get sum of outcomes by category
-// @Description Get the total amount of outcomes by category between dates (defaults to current month if not provided), optionally filtered by category
+// @Description Get the total amount of outcomes by category between dates (defaults to current month if not provided), optionally filtered by category. Categories without outcomes in the period are returned with a zero total.
 // @Tags         outcomes
 // @Accept       json
 // @Produce      json
 // @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
 // @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
-// @Param        categoryId query int false "Category ID filter"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        categoryId query int false "Category ID filter (must be positive when provided)"
+// @Param        allTime query   bool    false  "Aggregate over all time without date defaulting (cannot be combined with date filters)"
+// @Param        withCount query bool    false  "Also return the number of outcomes of each category (defaults to false)"
+// @Param        signed query   bool    false  "Serialize outcome amounts as negative numbers (defaults to false)"
 // @Success      200   {object}   SumOutcomeResponse
 // @Failure      400   {object}   ErrorResponse  "Bad request error"
 // @Failure      401   {object}   ErrorResponse  "Unauthorized error"
 // @Failure      404   {object}   ErrorResponse  "Not found error"
+// @Failure      422   {object}   ErrorResponse  "Validation error"
 // @Failure      500   {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /outcomes/sums-by-category [get]
@@ -384,32 +490,23 @@ func (h *OutcomeHandler) GetOutcomesSum(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var from, to *time.Time
 	var categoryId int
 
-	fromStr := r.URL.Query().Get("from")
-	if fromStr != "" {
-		parsedFrom, err := time.Parse(time.RFC3339, fromStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'from' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		from = &parsedFrom
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
-	toStr := r.URL.Query().Get("to")
-	if toStr != "" {
-		parsedTo, err := time.Parse(time.RFC3339, toStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'to' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		to = &parsedTo
+	allTime, err := parseAllTime(r, from, to)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
 	// If no dates provided, default to current month
-	if from == nil && to == nil {
-		now := time.Now()
+	if from == nil && to == nil && !allTime {
+		now := time.Now().In(h.location)
 		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
 		from = &firstDayOfMonth
 		to = &now
@@ -418,262 +515,1130 @@ func (h *OutcomeHandler) GetOutcomesSum(w http.ResponseWriter, r *http.Request)
 	categoryIdStr := r.URL.Query().Get("categoryId")
 	if categoryIdStr != "" {
 		categoryIdInt, err := strconv.Atoi(categoryIdStr)
-		if err != nil {
+		if err != nil || categoryIdInt <= 0 {
 			utils.WriteJSONError(w, http.StatusBadRequest, "invalid category")
 			return
 		}
 		categoryId = categoryIdInt
 	}
 
+	withCount, err := parseBoolParam(r.URL.Query().Get("withCount"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'withCount' value, use true or false")
+		return
+	}
+
+	signed, err := parseBoolParam(r.URL.Query().Get("signed"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'signed' value, use true or false")
+		return
+	}
+
 	categorySums, err := h.service.GetSum(r.Context(), from, to, categoryId, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidDateError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
 	var categorySumsResp []CategorySumResponse
 	for _, i := range categorySums {
-		categorySumsResp = append(categorySumsResp, CategorySumResponse{
+		categorySumResp := CategorySumResponse{
 			CategoryId: i.CategoryId,
-			Total:      i.Total,
-		})
+			Total:      signedOutcomeAmount(i.Total, signed),
+		}
+		if withCount {
+			categorySumResp.Count = &i.Count
+		}
+		categorySumsResp = append(categorySumsResp, categorySumResp)
 	}
 
 	utils.WriteJSON(w, http.StatusOK, categorySumsResp)
 }
 
-// Get total of outcomes
-// @Summary      Get total of outcomes
-// @Description Get the total amount of outcomes between dates (defaults to current month if not provided)
+// Get average outcome amount by category
+// @Summary      Get average outcome amount by category
+// @Description Get the average amount and the number of outcomes by category between dates (defaults to current month if not provided)
 // @Tags         outcomes
 // @Accept       json
 // @Produce      json
 // @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
 // @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
-// @Success      200   {object}   TotalOutcomeResponse
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        allTime query   bool    false  "Aggregate over all time without date defaulting (cannot be combined with date filters)"
+// @Success      200   {object}   AverageOutcomeResponse
 // @Failure      400   {object}   ErrorResponse  "Bad request error"
 // @Failure      401   {object}   ErrorResponse  "Unauthorized error"
 // @Failure      500   {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
-// @Router       /outcomes/total [get]
-func (h *OutcomeHandler) GetOutcomesTotal(w http.ResponseWriter, r *http.Request) {
+// @Router       /outcomes/avg-by-category [get]
+func (h *OutcomeHandler) GetOutcomesAverageByCategory(w http.ResponseWriter, r *http.Request) {
 	userId, ok := auth.GetUserIDFromContext(r.Context())
 	if !ok {
 		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
 		return
 	}
 
-	var from, to *time.Time
-
-	fromStr := r.URL.Query().Get("from")
-	if fromStr != "" {
-		parsedFrom, err := time.Parse(time.RFC3339, fromStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'from' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		from = &parsedFrom
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
-	toStr := r.URL.Query().Get("to")
-	if toStr != "" {
-		parsedTo, err := time.Parse(time.RFC3339, toStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'to' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		to = &parsedTo
+	allTime, err := parseAllTime(r, from, to)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
 	// If no dates provided, default to current month
-	if from == nil && to == nil {
-		now := time.Now()
+	if from == nil && to == nil && !allTime {
+		now := time.Now().In(h.location)
 		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
 		from = &firstDayOfMonth
 		to = &now
 	}
 
-	total, err := h.service.GetTotal(r.Context(), from, to, userId)
+	averages, err := h.service.GetAverageByCategory(r.Context(), from, to, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidDateError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK, TotalOutcomeResponse{Total: total})
+	averagesResp := []CategoryAverageResponse{}
+	for _, a := range averages {
+		averagesResp = append(averagesResp, CategoryAverageResponse{
+			CategoryId: a.CategoryId,
+			Average:    a.Average,
+			Count:      a.Count,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, averagesResp)
 }
 
-// Get monthly series of outcomes
-// @Summary      Get monthly series of outcomes
-// @Description Get the sum of outcomes by category for each month between dates (defaults to last 12 months if not provided)
+// Get outlier outcomes
+// @Summary      Get outlier outcomes
+// @Description Get the outcomes whose amount exceeds `factor` times their category's average between dates (defaults to current month if not provided)
 // @Tags         outcomes
 // @Accept       json
 // @Produce      json
-// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to 12 months ago)"
-// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
-// @Success      200   {array}   SeriesOutcomeResponse
+// @Param        from    query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
+// @Param        to      query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month   query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        factor  query     number  false  "Multiple of the category average an outcome must exceed (defaults to 2, at least 1)"
+// @Success      200   {array}    OutlierOutcomeResponse
 // @Failure      400   {object}   ErrorResponse  "Bad request error"
 // @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422   {object}   ErrorResponse  "Validation error"
 // @Failure      500   {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
-// @Router       /outcomes/series-by-category [get]
-func (h *OutcomeHandler) GetOutcomesSeries(w http.ResponseWriter, r *http.Request) {
+// @Router       /outcomes/outliers [get]
+func (h *OutcomeHandler) GetOutcomesOutliers(w http.ResponseWriter, r *http.Request) {
 	userId, ok := auth.GetUserIDFromContext(r.Context())
 	if !ok {
 		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
 		return
 	}
 
-	var from, to *time.Time
-
-	fromStr := r.URL.Query().Get("from")
-	if fromStr != "" {
-		parsedFrom, err := time.Parse(time.RFC3339, fromStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'from' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		from = &parsedFrom
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
-	toStr := r.URL.Query().Get("to")
-	if toStr != "" {
-		parsedTo, err := time.Parse(time.RFC3339, toStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'to' date format, use ISO 8601 (RFC3339)")
+	factor := domain.DefaultOutlierFactor
+	factorStr := r.URL.Query().Get("factor")
+	if factorStr != "" {
+		parsedFactor, err := strconv.ParseFloat(factorStr, 64)
+		if err != nil || math.IsNaN(parsedFactor) || math.IsInf(parsedFactor, 0) {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'factor' value, use a number")
 			return
 		}
-		to = &parsedTo
+		factor = parsedFactor
 	}
 
-	// If only one date or no dates provided, default to (last) 12 months
-	if from == nil || to == nil {
-		if from == nil && to == nil {
-			now := time.Now()
-			twelveMonthsAgo := now.AddDate(0, -12, 0)
-			from = &twelveMonthsAgo
-			to = &now
-		} else if from == nil {
-			twelveMonthsAgo := to.AddDate(0, -12, 0)
-			from = &twelveMonthsAgo
-		} else {
-			twelveMonthsAfter := from.AddDate(0, 12, 0)
-			to = &twelveMonthsAfter
-		}
+	// If no dates provided, default to current month
+	if from == nil && to == nil {
+		now := time.Now().In(h.location)
+		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		from = &firstDayOfMonth
+		to = &now
 	}
 
-	series, err := h.service.GetSeries(r.Context(), from, to, userId)
+	outliers, err := h.service.GetOutliers(r.Context(), from, to, factor, userId)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidDateError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
-	var seriesResp []MonthlySeries
-	for _, i := range series {
-		seriesResp = append(seriesResp, MonthlySeries{
-			Month:      i.Month,
-			Categories: i.Categories,
+	outliersResp := []OutlierOutcomeResponse{}
+	for _, o := range outliers {
+		outliersResp = append(outliersResp, OutlierOutcomeResponse{
+			OutcomeResponse: OutcomeResponse{
+				ID:         o.ID,
+				Name:       o.Name,
+				Amount:     o.Amount,
+				CategoryId: o.CategoryId,
+				CreatedAt:  o.CreatedAt,
+			},
+			CategoryAverage: o.CategoryAverage,
 		})
 	}
 
-	utils.WriteJSON(w, http.StatusOK, seriesResp)
+	utils.WriteJSON(w, http.StatusOK, outliersResp)
 }
 
-// Get monthly series of outcomes' total amount
-// @Summary      Get monthly series of outcomes' total amount
-// @Description Get the total sum of outcomes for each month between dates (defaults to last 12 months if not provided)
+// Get outcomes by hour of day
+// @Summary      Get outcomes by hour of day
+// @Description Get the total amount of outcomes for each hour of the day (0-23) between dates (defaults to current month if not provided). Hours without outcomes are returned with a zero total.
 // @Tags         outcomes
 // @Accept       json
 // @Produce      json
-// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to 12 months ago)"
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
 // @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
-// @Success      200   {array}   TotalSeriesOutcomeResponse
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        tz    query     string  false  "IANA timezone used to bucket hours (ex: Europe/Paris, defaults to the server timezone)"
+// @Param        allTime query   bool    false  "Aggregate over all time without date defaulting (cannot be combined with date filters)"
+// @Success      200   {object}   ByHourOutcomeResponse
 // @Failure      400   {object}   ErrorResponse  "Bad request error"
 // @Failure      401   {object}   ErrorResponse  "Unauthorized error"
 // @Failure      500   {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
-// @Router       /outcomes/series-total [get]
-func (h *OutcomeHandler) GetOutcomesTotalSeries(w http.ResponseWriter, r *http.Request) {
+// @Router       /outcomes/by-hour [get]
+func (h *OutcomeHandler) GetOutcomesByHour(w http.ResponseWriter, r *http.Request) {
 	userId, ok := auth.GetUserIDFromContext(r.Context())
 	if !ok {
 		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
 		return
 	}
 
-	var from, to *time.Time
-
-	fromStr := r.URL.Query().Get("from")
-	if fromStr != "" {
-		parsedFrom, err := time.Parse(time.RFC3339, fromStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'from' date format, use ISO 8601 (RFC3339)")
-			return
-		}
-		from = &parsedFrom
-	}
-
-	toStr := r.URL.Query().Get("to")
-	if toStr != "" {
-		parsedTo, err := time.Parse(time.RFC3339, toStr)
-		if err != nil {
-			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'to' date format, use ISO 8601 (RFC3339)")
+	location := h.location
+	tz := r.URL.Query().Get("tz")
+	if tz != "" {
+		// "Local" is the server's zone to Go but unknown to Postgres
+		loaded, err := time.LoadLocation(tz)
+		if err != nil || tz == "Local" {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'tz' value, use an IANA timezone name (ex: Europe/Paris)")
 			return
 		}
-		to = &parsedTo
+		location = loaded
 	}
 
-	// If only one date or no dates provided, default to (last) 12 months
-	if from == nil || to == nil {
-		if from == nil && to == nil {
-			now := time.Now()
-			twelveMonthsAgo := now.AddDate(0, -12, 0)
-			from = &twelveMonthsAgo
-			to = &now
-		} else if from == nil {
-			twelveMonthsAgo := to.AddDate(0, -12, 0)
-			from = &twelveMonthsAgo
-		} else {
-			twelveMonthsAfter := from.AddDate(0, 12, 0)
-			to = &twelveMonthsAfter
-		}
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
-	series, err := h.service.GetTotalSeries(r.Context(), from, to, userId)
+	allTime, err := parseAllTime(r, from, to)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidDateError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	var seriesResp []MonthlyTotalSeries
-	for _, i := range series {
-		seriesResp = append(seriesResp, MonthlyTotalSeries{
-			Month: i.Month,
-			Total: i.Total,
+	// If no dates provided, default to current month
+	if from == nil && to == nil && !allTime {
+		now := time.Now().In(location)
+		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location)
+		from = &firstDayOfMonth
+		to = &now
+	}
+
+	sums, err := h.service.GetSumByHour(r.Context(), from, to, location.String(), userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	sumsResp := ByHourOutcomeResponse{}
+	for _, s := range sums {
+		sumsResp = append(sumsResp, HourlySumResponse{
+			Hour:  s.Hour,
+			Total: s.Total,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, sumsResp)
+}
+
+// Get most frequent outcome names
+// @Summary      Get most frequent outcome names
+// @Description  Get the outcome names logged the most often between dates (defaults to current month if not provided), such as usual merchants, with their count and total, by descending count
+// @Tags         outcomes
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        limit query     int     false  "Items limit (defaults to 10, max 100)"
+// @Success      200   {array}   FrequentNameResponse
+// @Failure      400   {object}  ErrorResponse  "Bad request error"
+// @Failure      401   {object}  ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}  ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/frequent [get]
+func (h *OutcomeHandler) GetOutcomesFrequentNames(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	limit := domain.DefaultFrequentLimit
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// If no dates provided, default to current month
+	if from == nil && to == nil {
+		now := time.Now().In(h.location)
+		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, h.location)
+		from = &firstDayOfMonth
+		to = &now
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		if parsedLimit > domain.MaxLimit {
+			utils.WriteJSONError(w, http.StatusBadRequest, "limit must be less than or equal to 100")
+			return
+		}
+		limit = parsedLimit
+	}
+
+	frequencies, err := h.service.GetFrequentNames(r.Context(), from, to, userId, limit)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	frequenciesResp := []FrequentNameResponse{}
+	for _, f := range frequencies {
+		frequenciesResp = append(frequenciesResp, FrequentNameResponse{
+			Name:  f.Name,
+			Count: f.Count,
+			Total: f.Total,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, frequenciesResp)
+}
+
+// Get histogram of outcome amounts
+// @Summary      Get histogram of outcome amounts
+// @Description Get the number and total of outcomes in each amount bucket between dates (defaults to current month if not provided). Buckets are given by their ascending lower bounds in cents, each one ending where the next starts and the last one being unbounded. Outcomes under the first bound are left out.
+// @Tags         outcomes
+// @Produce      json
+// @Param        from     query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
+// @Param        to       query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month    query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        buckets  query     string  false  "Comma separated lower bounds of the buckets in cents (defaults to 0,1000,5000,10000,50000)"
+// @Success      200   {object}   HistogramOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422   {object}   ErrorResponse  "Validation error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/histogram [get]
+func (h *OutcomeHandler) GetOutcomesHistogram(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	bounds := domain.DefaultHistogramBuckets
+	if bucketsStr := r.URL.Query().Get("buckets"); bucketsStr != "" {
+		bounds = nil
+		for v := range strings.SplitSeq(bucketsStr, ",") {
+			bound, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'buckets' value, use comma separated amounts in cents (ex: 0,1000,5000)")
+				return
+			}
+			bounds = append(bounds, bound)
+		}
+	}
+
+	// If no dates provided, default to current month
+	if from == nil && to == nil {
+		now := time.Now().In(h.location)
+		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, h.location)
+		from = &firstDayOfMonth
+		to = &now
+	}
+
+	buckets, err := h.service.GetHistogram(r.Context(), from, to, bounds, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	bucketsResp := HistogramOutcomeResponse{}
+	for _, b := range buckets {
+		bucketsResp = append(bucketsResp, AmountBucketResponse{
+			Min:   b.Min,
+			Max:   b.Max,
+			Count: b.Count,
+			Total: b.Total,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, bucketsResp)
+}
+
+// Get total of outcomes
+// @Summary      Get total of outcomes
+// @Description Get the total amount of outcomes between dates (defaults to current month if not provided)
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        allTime query   bool    false  "Aggregate over all time without date defaulting (cannot be combined with date filters)"
+// @Param        signed query   bool    false  "Serialize outcome amounts as negative numbers (defaults to false)"
+// @Success      200   {object}   TotalOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/total [get]
+func (h *OutcomeHandler) GetOutcomesTotal(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	allTime, err := parseAllTime(r, from, to)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// If no dates provided, default to current month
+	if from == nil && to == nil && !allTime {
+		now := time.Now().In(h.location)
+		firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		from = &firstDayOfMonth
+		to = &now
+	}
+
+	signed, err := parseBoolParam(r.URL.Query().Get("signed"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'signed' value, use true or false")
+		return
+	}
+
+	total, err := h.service.GetTotal(r.Context(), from, to, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, TotalOutcomeResponse{Total: signedOutcomeAmount(total, signed)})
+}
+
+// Get monthly series of outcomes
+// @Summary      Get monthly series of outcomes
+// @Description Get the sum of outcomes by category for each month between dates (defaults to last 12 months if not provided)
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to 12 months ago)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        signed query   bool    false  "Serialize outcome amounts as negative numbers (defaults to false)"
+// @Success      200   {array}   SeriesOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/series-by-category [get]
+func (h *OutcomeHandler) GetOutcomesSeries(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	signed, err := parseBoolParam(r.URL.Query().Get("signed"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'signed' value, use true or false")
+		return
+	}
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	allTime, err := parseBoolParam(r.URL.Query().Get("allTime"))
+	if err != nil || allTime {
+		utils.WriteJSONError(w, http.StatusBadRequest, "'allTime' is not supported on series, use 'from' and 'to'")
+		return
+	}
+
+	// If only one date or no dates provided, default to (last) 12 months
+	if from == nil || to == nil {
+		if from == nil && to == nil {
+			now := time.Now().In(h.location)
+			twelveMonthsAgo := now.AddDate(0, -12, 0)
+			from = &twelveMonthsAgo
+			to = &now
+		} else if from == nil {
+			twelveMonthsAgo := to.AddDate(0, -12, 0)
+			from = &twelveMonthsAgo
+		} else {
+			twelveMonthsAfter := from.AddDate(0, 12, 0)
+			to = &twelveMonthsAfter
+		}
+	}
+
+	series, err := h.service.GetSeries(r.Context(), from, to, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	var seriesResp []MonthlySeries
+	for _, i := range series {
+		categories := make(map[int]int, len(i.Categories))
+		for categoryId, total := range i.Categories {
+			categories[categoryId] = signedOutcomeAmount(total, signed)
+		}
+		seriesResp = append(seriesResp, MonthlySeries{
+			Month:      i.Month,
+			Categories: categories,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, seriesResp)
+}
+
+// Get monthly series of outcomes' total amount
+// @Summary      Get monthly series of outcomes' total amount
+// @Description Get the total sum of outcomes for each month between dates (defaults to last 12 months if not provided)
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to 12 months ago)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Param        signed query   bool    false  "Serialize outcome amounts as negative numbers (defaults to false)"
+// @Success      200   {array}   TotalSeriesOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/series-total [get]
+func (h *OutcomeHandler) GetOutcomesTotalSeries(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	signed, err := parseBoolParam(r.URL.Query().Get("signed"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'signed' value, use true or false")
+		return
+	}
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	allTime, err := parseBoolParam(r.URL.Query().Get("allTime"))
+	if err != nil || allTime {
+		utils.WriteJSONError(w, http.StatusBadRequest, "'allTime' is not supported on series, use 'from' and 'to'")
+		return
+	}
+
+	// If only one date or no dates provided, default to (last) 12 months
+	if from == nil || to == nil {
+		if from == nil && to == nil {
+			now := time.Now().In(h.location)
+			twelveMonthsAgo := now.AddDate(0, -12, 0)
+			from = &twelveMonthsAgo
+			to = &now
+		} else if from == nil {
+			twelveMonthsAgo := to.AddDate(0, -12, 0)
+			from = &twelveMonthsAgo
+		} else {
+			twelveMonthsAfter := from.AddDate(0, 12, 0)
+			to = &twelveMonthsAfter
+		}
+	}
+
+	series, err := h.service.GetTotalSeries(r.Context(), from, to, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	var seriesResp []MonthlyTotalSeries
+	for _, i := range series {
+		seriesResp = append(seriesResp, MonthlyTotalSeries{
+			Month: i.Month,
+			Total: signedOutcomeAmount(i.Total, signed),
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, seriesResp)
+}
+
+// Get monthly counts of outcomes
+// @Summary      Get monthly counts of outcomes
+// @Description Get the number of outcomes of each month between dates (defaults to last 12 months if not provided), months without outcomes counting zero. Suited to activity sparklines.
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to 12 months ago)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Success      200   {array}   MonthlyCountsOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/monthly-counts [get]
+func (h *OutcomeHandler) GetOutcomesMonthlyCounts(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// If only one date or no dates provided, default to (last) 12 months
+	if from == nil || to == nil {
+		if from == nil && to == nil {
+			now := time.Now().In(h.location)
+			twelveMonthsAgo := now.AddDate(0, -12, 0)
+			from = &twelveMonthsAgo
+			to = &now
+		} else if from == nil {
+			twelveMonthsAgo := to.AddDate(0, -12, 0)
+			from = &twelveMonthsAgo
+		} else {
+			twelveMonthsAfter := from.AddDate(0, 12, 0)
+			to = &twelveMonthsAfter
+		}
+	}
+
+	counts, err := h.service.GetMonthlyCounts(r.Context(), from, to, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	countsResp := make(MonthlyCountsOutcomeResponse, 0, len(counts))
+	for _, c := range counts {
+		countsResp = append(countsResp, MonthlyCount{
+			Month: c.Month,
+			Count: c.Count,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, countsResp)
+}
+
+// Get rolling total of outcomes
+// @Summary      Get rolling total of outcomes
+// @Description Get the total amount of outcomes over a trailing window ending now, independently of calendar months
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        window query    string  false  "Trailing window in days (ex: 30d, defaults to 30d, max 365d)"
+// @Success      200   {object}   RollingOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422   {object}   ErrorResponse  "Validation error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/rolling [get]
+func (h *OutcomeHandler) GetOutcomesRolling(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	days := domain.DefaultRollingWindowDays
+	windowStr := r.URL.Query().Get("window")
+	if windowStr != "" {
+		parsedDays, err := parseWindowDays(windowStr)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'window' format, use a number of days (ex: 30d)")
+			return
+		}
+		days = parsedDays
+	}
+
+	rolling, err := h.service.GetRollingTotal(r.Context(), time.Now().In(h.location), days, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, RollingOutcomeResponse{
+		Window: strconv.Itoa(days) + "d",
+		From:   rolling.From,
+		To:     rolling.To,
+		Total:  rolling.Total,
+	})
+}
+
+// Get outcomes forecast
+// @Summary      Get outcomes forecast
+// @Description Forecast the outcomes of the next months, each category being predicted at its average over the last 6 complete months (months without outcomes counting as zero)
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        months  query     int  false  "Number of months to forecast (defaults to 3, max 12)"
+// @Success      200   {object}   ForecastOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422   {object}   ErrorResponse  "Validation error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/forecast [get]
+func (h *OutcomeHandler) GetOutcomesForecast(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	months := domain.DefaultForecastMonths
+	monthsStr := r.URL.Query().Get("months")
+	if monthsStr != "" {
+		parsedMonths, err := strconv.Atoi(monthsStr)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'months' value, use a number of months")
+			return
+		}
+		months = parsedMonths
+	}
+
+	forecast, err := h.service.GetForecast(r.Context(), time.Now().In(h.location), months, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	forecastResp := ForecastOutcomeResponse{}
+	for _, f := range forecast {
+		forecastResp = append(forecastResp, MonthlyForecast{
+			Month:      f.Month,
+			Categories: f.Categories,
+			Total:      f.Total,
 		})
 	}
 
-	utils.WriteJSON(w, http.StatusOK, seriesResp)
+	utils.WriteJSON(w, http.StatusOK, forecastResp)
+}
+
+// Get spending streaks
+// @Summary      Get spending streaks
+// @Description Get the longest run of consecutive days with at least one outcome and the longest run of days without any, between dates (defaults to current month if not provided). Days are taken in the server timezone. The range may span at most 3660 days.
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of current month)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Success      200   {object}   StreaksOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422   {object}   ErrorResponse  "Validation error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/streaks [get]
+func (h *OutcomeHandler) GetOutcomesStreaks(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	parsedFrom, parsedTo, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	now := time.Now().In(h.location)
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, h.location)
+	to := now
+
+	if parsedFrom != nil {
+		from = *parsedFrom
+	}
+	if parsedTo != nil {
+		to = *parsedTo
+	}
+
+	streaks, err := h.service.GetStreaks(r.Context(), from, to, h.location, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, StreaksOutcomeResponse{
+		LongestSpending: StreakResponse{
+			Days: streaks.LongestSpending.Days,
+			From: streaks.LongestSpending.From,
+			To:   streaks.LongestSpending.To,
+		},
+		LongestNoSpend: StreakResponse{
+			Days: streaks.LongestNoSpend.Days,
+			From: streaks.LongestNoSpend.From,
+			To:   streaks.LongestNoSpend.To,
+		},
+	})
+}
+
+// Get outcomes grouped by interval
+// @Summary      Get outcomes grouped by interval
+// @Description Get the total and number of outcomes of each day, week, month, quarter or year between dates (defaults to the last 12 months if not provided), periods without outcomes included. Periods are taken in the server timezone, weeks starting on Monday. The range may span at most 400 periods.
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        interval query  string  false  "Period length: day, week, month, quarter or year (defaults to month)"
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to first day of the month 11 months ago)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Success      200   {object}   GroupedOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422   {object}   ErrorResponse  "Validation error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/grouped [get]
+func (h *OutcomeHandler) GetOutcomesGrouped(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	interval := domain.IntervalMonth
+	if intervalStr := r.URL.Query().Get("interval"); intervalStr != "" {
+		interval = domain.Interval(intervalStr)
+	}
+
+	parsedFrom, parsedTo, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	now := time.Now().In(h.location)
+	from := time.Date(now.Year(), now.Month()-11, 1, 0, 0, 0, 0, h.location)
+	to := now
+
+	if parsedFrom != nil {
+		from = *parsedFrom
+	}
+	if parsedTo != nil {
+		to = *parsedTo
+	}
+
+	sums, err := h.service.GetGroupedSums(r.Context(), from, to, interval, h.location, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	sumsResp := GroupedOutcomeResponse{}
+	for _, s := range sums {
+		sumsResp = append(sumsResp, PeriodSumResponse{
+			Start: s.Start,
+			Total: s.Total,
+			Count: s.Count,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, sumsResp)
+}
+
+// Get projected month-end outcomes
+// @Summary      Get projected month-end outcomes
+// @Description Project the current month's total outcomes linearly from the spending so far
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Success      200   {object}   ProjectionOutcomeResponse
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/projection [get]
+func (h *OutcomeHandler) GetOutcomesProjection(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	projection, err := h.service.GetProjection(r.Context(), time.Now().In(h.location), userId)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, ProjectionOutcomeResponse{
+		SpentSoFar:     projection.SpentSoFar,
+		DaysElapsed:    projection.DaysElapsed,
+		DaysInMonth:    projection.DaysInMonth,
+		ProjectedTotal: projection.ProjectedTotal,
+	})
+}
+
+// Get new categories of outcomes
+// @Summary      Get new categories of outcomes
+// @Description Get the categories with outcomes during the given month but none during the previous month (defaults to current month if not provided)
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        month  query     string  false  "Month (YYYY-MM format, defaults to current month)"
+// @Success      200   {object}   SumOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/new-categories [get]
+func (h *OutcomeHandler) GetOutcomesNewCategories(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	month := time.Now().In(h.location)
+
+	monthStr := r.URL.Query().Get("month")
+	if monthStr != "" {
+		parsedMonth, err := parseMonth(monthStr, h.location)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'month' format, use YYYY-MM")
+			return
+		}
+		month = parsedMonth
+	}
+
+	categorySums, err := h.service.GetNewCategories(r.Context(), month, userId)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	categorySumsResp := []CategorySumResponse{}
+	for _, i := range categorySums {
+		categorySumsResp = append(categorySumsResp, CategorySumResponse{
+			CategoryId: i.CategoryId,
+			Total:      i.Total,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, categorySumsResp)
+}
+
+// Get outcomes year over year
+// @Summary      Get outcomes year over year
+// @Description Compare the total of outcomes of a month with the same month a year earlier (defaults to current month if not provided)
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Param        month  query     string  false  "Month (YYYY-MM format, defaults to current month)"
+// @Success      200   {object}   YearOverYearOutcomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/year-over-year [get]
+func (h *OutcomeHandler) GetOutcomesYearOverYear(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	month := time.Now().In(h.location)
+
+	monthStr := r.URL.Query().Get("month")
+	if monthStr != "" {
+		parsedMonth, err := parseMonth(monthStr, h.location)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'month' format, use YYYY-MM")
+			return
+		}
+		month = parsedMonth
+	}
+
+	yoy, err := h.service.GetYearOverYear(r.Context(), month, userId)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, YearOverYearOutcomeResponse{
+		Month:         yoy.Month,
+		Total:         yoy.Total,
+		PreviousMonth: yoy.PreviousMonth,
+		PreviousTotal: yoy.PreviousTotal,
+		Delta:         yoy.Delta,
+		Percent:       yoy.Percent,
+	})
+}
+
+// Get date bounds of outcomes
+// @Summary      Get date bounds of outcomes
+// @Description Get the dates of the earliest and latest outcomes, or no content if there are none
+// @Tags         outcomes
+// @Accept       json
+// @Produce      json
+// @Success      200   {object}   DateBoundsResponse
+// @Success      204   "No Content"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/date-bounds [get]
+func (h *OutcomeHandler) GetOutcomesDateBounds(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	bounds, err := h.service.GetDateBounds(r.Context(), userId)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if bounds == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, DateBoundsResponse{
+		Min: bounds.Min,
+		Max: bounds.Max,
+	})
+}
+
+// Export outcomes as an Excel file
+// @Summary      Export outcomes as an Excel file
+// @Description Download the outcomes as an XLSX workbook with a header row and formatted dates and amounts, honoring the list filters. If no dates are provided, the current month is exported. Exports of more than 50000 outcomes are rejected with a 400.
+// @Tags         outcomes
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        from query      string  false  "Start date (ISO 8601)"
+// @Param        to query        string  false  "End date (ISO 8601)"
+// @Param        month query     string  false  "Month (YYYY-MM, in the configured timezone), cannot be combined with from/to"
+// @Param        categoryId query  int     false  "Category ID"
+// @Success      200   {file}    file
+// @Failure      400   {object}  ErrorResponse  "Bad request error"
+// @Failure      401   {object}  ErrorResponse  "Unauthorized error"
+// @Failure      404   {object}  ErrorResponse  "Not found error"
+// @Failure      422   {object}  ErrorResponse  "Validation error"
+// @Failure      500   {object}  ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /outcomes/export.xlsx [get]
+func (h *OutcomeHandler) ExportOutcomesXLSX(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	var categoryId int
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	categoryIdStr := r.URL.Query().Get("categoryId")
+	if categoryIdStr != "" {
+		categoryIdInt, err := strconv.Atoi(categoryIdStr)
+		if err != nil || categoryIdInt <= 0 {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid category")
+			return
+		}
+		categoryId = categoryIdInt
+	}
+
+	// If no dates provided, default to current month
+	if from == nil && to == nil {
+		now := time.Now().In(h.location)
+		from = &time.Time{}
+		*from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		to = &now
+	}
+
+	outcomes, err := h.service.Export(r.Context(), from, to, categoryId, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	sheet := xlsx.Sheet{
+		Name:   "Outcomes",
+		Header: outcomesExportHeader,
+		Rows:   make([][]any, 0, len(outcomes)),
+	}
+	for _, o := range outcomes {
+		sheet.Rows = append(sheet.Rows, []any{o.CreatedAt.In(h.location), o.Name, o.CategoryLabel, xlsx.Money(o.Amount)})
+	}
+
+	// Laid out before answering so that a failure still gets an error status
+	workbook, err := xlsx.New(sheet)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer workbook.Close()
+
+	w.Header().Set("Content-Type", xlsx.ContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="outcomes.xlsx"`)
+	w.WriteHeader(http.StatusOK)
+	workbook.WriteTo(w)
 }
 
+// outcomesExportHeader is the header row of the outcome exports.
+var outcomesExportHeader = []string{"Date", "Name", "Category", "Amount"}
+
 func toOutcomeResponse(outcome *domain.Outcome) OutcomeResponse {
 	return OutcomeResponse{
 		Name:       outcome.Name,
@@ -694,3 +1659,19 @@ func toOutcomesResponse(outcomes []domain.Outcome) []OutcomeResponse {
 	}
 	return outcomesResp
 }
+
+// signedOutcomeAmount returns the amount as a negative number when the client asked
+// for signed amounts, outcomes being money going out.
+func signedOutcomeAmount(amount int, signed bool) int {
+	if signed {
+		return -amount
+	}
+	return amount
+}
+
+func signOutcomesResponse(outcomes []OutcomeResponse, signed bool) []OutcomeResponse {
+	for i := range outcomes {
+		outcomes[i].Amount = signedOutcomeAmount(outcomes[i].Amount, signed)
+	}
+	return outcomes
+}
@@ -8,26 +8,30 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/config"
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/kerhael/accounting/internal/service/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
 )
 
 func TestOutcomeHandler_PostOutcome_Success(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	input := CreateOutcomeRequest{
 		Name:       "Restaurant",
 		Amount:     1999,
 		CategoryId: 1,
-		CreatedAt:  createdAt,
+		CreatedAt:  RequestTime{Time: createdAt},
 	}
 	body, _ := json.Marshal(input)
 
@@ -69,7 +73,7 @@ func TestOutcomeHandler_PostOutcome_Success(t *testing.T) {
 
 func TestOutcomeHandler_PostOutcome_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodPost, "/outcomes/", nil)
 
@@ -88,7 +92,7 @@ func TestOutcomeHandler_PostOutcome_NoAuthContext(t *testing.T) {
 
 func TestOutcomeHandler_PostOutcome_InvalidJSON(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodPost, "/outcomes/", bytes.NewReader([]byte("invalid json")))
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -105,17 +109,20 @@ func TestOutcomeHandler_PostOutcome_InvalidJSON(t *testing.T) {
 
 func TestOutcomeHandler_PostOutcome_MissingName(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	input := CreateOutcomeRequest{
 		Amount:     1999,
 		CategoryId: 1,
-		CreatedAt:  time.Now(),
+		CreatedAt:  RequestTime{Time: time.Now()},
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Create", ctx, "", 1999, 1, mock.Anything, 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("invalid name")})
+
 	req := httptest.NewRequest(http.MethodPost, "/outcomes/", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
@@ -124,26 +131,29 @@ func TestOutcomeHandler_PostOutcome_MissingName(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "name is required")
+	assert.Contains(t, string(bodyBytes), "invalid name")
 }
 
 func TestOutcomeHandler_PostOutcome_InvalidAmount(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	input := CreateOutcomeRequest{
 		Name:       "Restaurant",
 		Amount:     0,
 		CategoryId: 1,
-		CreatedAt:  time.Now(),
+		CreatedAt:  RequestTime{Time: time.Now()},
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Create", ctx, "Restaurant", 0, 1, mock.Anything, 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("invalid amount")})
+
 	req := httptest.NewRequest(http.MethodPost, "/outcomes/", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
@@ -152,23 +162,26 @@ func TestOutcomeHandler_PostOutcome_InvalidAmount(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "amount is required and must be positive")
+	assert.Contains(t, string(bodyBytes), "invalid amount")
 }
 
-func TestOutcomeHandler_PostOutcome_MissingCategoryId(t *testing.T) {
+func TestOutcomeHandler_PostOutcome_MissingCategoryId_NoDefault(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	input := CreateOutcomeRequest{
 		Name:      "Restaurant",
 		Amount:    1999,
-		CreatedAt: time.Now(),
+		CreatedAt: RequestTime{Time: time.Now()},
 	}
 	body, _ := json.Marshal(input)
 
+	mockService.On("Create", mock.Anything, "Restaurant", 1999, 0, mock.AnythingOfType("*time.Time"), 123).
+		Return((*domain.Outcome)(nil), &domain.InvalidEntityError{UnderlyingCause: errors.New("category is required")})
+
 	req := httptest.NewRequest(http.MethodPost, "/outcomes/", bytes.NewReader(body))
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
@@ -179,21 +192,22 @@ func TestOutcomeHandler_PostOutcome_MissingCategoryId(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	assert.Contains(t, string(bodyBytes), "category is required")
+	mockService.AssertExpectations(t)
 }
 
 func TestOutcomeHandler_PostOutcome_ZeroCreatedAt(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	input := CreateOutcomeRequest{
 		Name:       "Restaurant",
 		Amount:     1999,
 		CategoryId: 1,
-		CreatedAt:  time.Time{},
+		CreatedAt:  RequestTime{Time: time.Time{}},
 	}
 	body, _ := json.Marshal(input)
 
@@ -215,14 +229,14 @@ func TestOutcomeHandler_PostOutcome_ZeroCreatedAt(t *testing.T) {
 
 func TestOutcomeHandler_PostOutcome_ServiceError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	createdAt := time.Now()
 	input := CreateOutcomeRequest{
 		Name:       "Restaurant",
 		Amount:     1999,
 		CategoryId: 1,
-		CreatedAt:  createdAt,
+		CreatedAt:  RequestTime{Time: createdAt},
 	}
 	body, _ := json.Marshal(input)
 
@@ -240,21 +254,21 @@ func TestOutcomeHandler_PostOutcome_ServiceError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestOutcomeHandler_PostOutcome_InternalError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	createdAt := time.Now()
 	input := CreateOutcomeRequest{
 		Name:       "Restaurant",
 		Amount:     1999,
 		CategoryId: 1,
-		CreatedAt:  createdAt,
+		CreatedAt:  RequestTime{Time: createdAt},
 	}
 	body, _ := json.Marshal(input)
 
@@ -277,9 +291,71 @@ func TestOutcomeHandler_PostOutcome_InternalError(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestOutcomeHandler_PostOutcome_ZonelessCreatedAt(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	paris, _ := time.LoadLocation("Europe/Paris")
+	handler := NewOutcomeHandler(mockService, paris, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	expected := time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC)
+	mockService.On("Create", ctx, "Restaurant", 1999, 1, mock.MatchedBy(func(t *time.Time) bool {
+		return t != nil && t.Equal(expected) && t.Location() == time.UTC
+	}), 123).Return(&domain.Outcome{ID: 1, Name: "Restaurant", Amount: 1999, CategoryId: 1, CreatedAt: &expected}, nil)
+
+	body := `{"name":"Restaurant","amount":1999,"categoryId":1,"createdAt":"2026-01-01T00:00:00"}`
+	req := httptest.NewRequest(http.MethodPost, "/outcomes/", strings.NewReader(body))
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.PostOutcome(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"createdAt":"2025-12-31T23:00:00Z"`)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_PostOutcome_InvalidCreatedAt(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	body := `{"name":"Restaurant","amount":1999,"categoryId":1,"createdAt":"01/01/2026"}`
+	req := httptest.NewRequest(http.MethodPost, "/outcomes/", strings.NewReader(body))
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.PostOutcome(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `invalid date \"01/01/2026\"`)
+	mockService.AssertNotCalled(t, "Create")
+}
+
+func TestOutcomeHandler_PatchOutcomeById_ZonelessCreatedAt(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	paris, _ := time.LoadLocation("Europe/Paris")
+	handler := NewOutcomeHandler(mockService, paris, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	expected := time.Date(2026, 7, 14, 8, 30, 0, 0, time.UTC)
+	mockService.On("PatchById", ctx, 1, "", 0, 0, mock.MatchedBy(func(t *time.Time) bool {
+		return t != nil && t.Equal(expected) && t.Location() == time.UTC
+	}), 123).Return(&domain.Outcome{ID: 1, Name: "Restaurant", Amount: 1999, CategoryId: 1, CreatedAt: &expected}, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/outcomes/1", strings.NewReader(`{"createdAt":"2026-07-14T10:30:00"}`))
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.PatchOutcomeById(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
 func TestOutcomeHandler_GetAllOutcomes_Success(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -329,9 +405,107 @@ func TestOutcomeHandler_GetAllOutcomes_Success(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestOutcomeHandler_GetAllOutcomes_OffsetReturnsNextCursor(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	createdAt := time.Date(2026, 1, 2, 9, 30, 0, 250000000, time.UTC)
+	mockService.On("GetAll", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId, 1, 0).
+		Return([]domain.Outcome{{ID: 4, Name: "Books", Amount: 2500, CategoryId: 1, CreatedAt: &createdAt}}, 3, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?limit=1", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data PaginatedOutcomesResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-02T09:30:00.25Z,4", data.NextCursor)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetAllOutcomes_WithAfterCursor(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	after := &domain.Cursor{CreatedAt: time.Date(2026, 1, 2, 9, 30, 0, 250000000, time.UTC), ID: 4}
+	createdAt := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	mockService.On("GetAllAfter", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId, after, 1).
+		Return([]domain.Outcome{{ID: 2, Name: "Rent", Amount: 90000, CategoryId: 4, CreatedAt: &createdAt}},
+			&domain.Cursor{CreatedAt: createdAt, ID: 2}, 3, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?limit=1&after=2026-01-02T09:30:00.25Z,4", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data PaginatedOutcomesResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Len(t, data.Data, 1)
+	assert.Equal(t, 2, data.Data[0].ID)
+	assert.Equal(t, "2026-01-01T08:00:00Z,2", data.NextCursor)
+	assert.Equal(t, 3, data.Pagination.Total)
+
+	mockService.AssertNotCalled(t, "GetAll")
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetAllOutcomes_AfterWithOffset(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?offset=20&after=2026-01-02T09:30:00Z,4", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestOutcomeHandler_GetAllOutcomes_InvalidAfterCursor(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?after=yesterday", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
 func TestOutcomeHandler_GetAllOutcomes_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/", nil)
 
@@ -350,7 +524,7 @@ func TestOutcomeHandler_GetAllOutcomes_NoAuthContext(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_EmptyList(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -380,7 +554,7 @@ func TestOutcomeHandler_GetAllOutcomes_EmptyList(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_WithDateFilters(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -425,7 +599,7 @@ func TestOutcomeHandler_GetAllOutcomes_WithDateFilters(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_WithCategoryFilter(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -467,9 +641,68 @@ func TestOutcomeHandler_GetAllOutcomes_WithCategoryFilter(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestOutcomeHandler_GetAllOutcomes_ZeroCategory(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?categoryId=0", nil)
+	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(bodyBytes), "invalid category")
+
+	mockService.AssertNotCalled(t, "GetAll")
+}
+
+func TestOutcomeHandler_GetAllOutcomes_NegativeCategory(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?categoryId=-3", nil)
+	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid category")
+
+	mockService.AssertNotCalled(t, "GetAll")
+}
+
+func TestOutcomeHandler_GetAllOutcomes_CategoryOmitted(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+
+	mockService.On("GetAll", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId, 20, 0).Return([]domain.Outcome{}, 0, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	mockService.AssertExpectations(t)
+}
+
 func TestOutcomeHandler_GetAllOutcomes_WithPagination(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -509,7 +742,7 @@ func TestOutcomeHandler_GetAllOutcomes_WithPagination(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_InvalidOffset(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/?offset=-1", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -525,7 +758,7 @@ func TestOutcomeHandler_GetAllOutcomes_InvalidOffset(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_InvalidLimit(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/?limit=101", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -541,7 +774,7 @@ func TestOutcomeHandler_GetAllOutcomes_InvalidLimit(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_BadFromAndToDates(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -565,7 +798,7 @@ func TestOutcomeHandler_GetAllOutcomes_BadFromAndToDates(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_InvalidFromDate(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/?from=invalid-date", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -585,7 +818,7 @@ func TestOutcomeHandler_GetAllOutcomes_InvalidFromDate(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_InvalidToDate(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/?to=invalid-date", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -603,9 +836,9 @@ func TestOutcomeHandler_GetAllOutcomes_InvalidToDate(t *testing.T) {
 	assert.Contains(t, string(bodyBytes), "invalid 'to' date format")
 }
 
-func TestOutcomeHandler_GetAllOutcomes_CategoryNotFound(t *testing.T) {
+func TestOutcomeHandler_GetAllOutcomes_InvalidCategory(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -621,7 +854,7 @@ func TestOutcomeHandler_GetAllOutcomes_CategoryNotFound(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	assert.Contains(t, string(bodyBytes), "invalid category")
@@ -629,7 +862,7 @@ func TestOutcomeHandler_GetAllOutcomes_CategoryNotFound(t *testing.T) {
 
 func TestOutcomeHandler_GetAllOutcomes_ServiceError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -651,7 +884,7 @@ func TestOutcomeHandler_GetAllOutcomes_ServiceError(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomeById_Success(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -691,7 +924,7 @@ func TestOutcomeHandler_GetOutcomeById_Success(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomeById_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/1", nil)
 
@@ -710,7 +943,7 @@ func TestOutcomeHandler_GetOutcomeById_NoAuthContext(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomeById_InvalidId(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/invalid", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -728,7 +961,7 @@ func TestOutcomeHandler_GetOutcomeById_InvalidId(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomeById_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -745,14 +978,14 @@ func TestOutcomeHandler_GetOutcomeById_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestOutcomeHandler_GetOutcomeById_EntityNotFoundError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -776,7 +1009,7 @@ func TestOutcomeHandler_GetOutcomeById_EntityNotFoundError(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomeById_ServiceError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -800,7 +1033,7 @@ func TestOutcomeHandler_GetOutcomeById_ServiceError(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_Success_NameOnly(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	name := "Restaurant"
 	input := PatchOutcomeByIdRequest{
@@ -846,7 +1079,7 @@ func TestOutcomeHandler_PatchOutcomeById_Success_NameOnly(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_Success_AllFields(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	name := "Restaurant"
 	amount := 2000
@@ -856,7 +1089,7 @@ func TestOutcomeHandler_PatchOutcomeById_Success_AllFields(t *testing.T) {
 		Name:       &name,
 		Amount:     &amount,
 		CategoryId: &categoryId,
-		CreatedAt:  &newCreatedAt,
+		CreatedAt:  &RequestTime{Time: newCreatedAt},
 	}
 	body, _ := json.Marshal(input)
 
@@ -900,7 +1133,7 @@ func TestOutcomeHandler_PatchOutcomeById_Success_AllFields(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodPatch, "/outcomes/1", nil)
 
@@ -919,7 +1152,7 @@ func TestOutcomeHandler_PatchOutcomeById_NoAuthContext(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_InvalidJSON(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodPatch, "/outcomes/1", bytes.NewReader([]byte("invalid json")))
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -937,7 +1170,7 @@ func TestOutcomeHandler_PatchOutcomeById_InvalidJSON(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_InvalidId(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	name := "Restaurant"
 	input := PatchOutcomeByIdRequest{
@@ -964,7 +1197,7 @@ func TestOutcomeHandler_PatchOutcomeById_InvalidId(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_NegativeAmount(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	amount := -100
 	input := PatchOutcomeByIdRequest{
@@ -972,8 +1205,11 @@ func TestOutcomeHandler_PatchOutcomeById_NegativeAmount(t *testing.T) {
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("PatchById", ctx, 1, "", -100, 0, (*time.Time)(nil), 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("amount must be positive")})
+
 	req := httptest.NewRequest(http.MethodPatch, "/outcomes/1", bytes.NewReader(body))
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	req.SetPathValue("id", "1")
 	w := httptest.NewRecorder()
@@ -983,7 +1219,7 @@ func TestOutcomeHandler_PatchOutcomeById_NegativeAmount(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	assert.Contains(t, string(bodyBytes), "amount must be positive")
@@ -991,7 +1227,7 @@ func TestOutcomeHandler_PatchOutcomeById_NegativeAmount(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_NegativeCategoryId(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	categoryId := -1
 	input := PatchOutcomeByIdRequest{
@@ -1018,7 +1254,7 @@ func TestOutcomeHandler_PatchOutcomeById_NegativeCategoryId(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	name := "Restaurant"
 	input := PatchOutcomeByIdRequest{
@@ -1041,14 +1277,14 @@ func TestOutcomeHandler_PatchOutcomeById_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestOutcomeHandler_PatchOutcomeById_EntityNotFoundError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	name := "Restaurant"
 	input := PatchOutcomeByIdRequest{
@@ -1078,7 +1314,7 @@ func TestOutcomeHandler_PatchOutcomeById_EntityNotFoundError(t *testing.T) {
 
 func TestOutcomeHandler_PatchOutcomeById_ServiceError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	name := "Restaurant"
 	input := PatchOutcomeByIdRequest{
@@ -1107,7 +1343,7 @@ func TestOutcomeHandler_PatchOutcomeById_ServiceError(t *testing.T) {
 
 func TestOutcomeHandler_DeleteOutcomeById_Success(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1130,7 +1366,7 @@ func TestOutcomeHandler_DeleteOutcomeById_Success(t *testing.T) {
 
 func TestOutcomeHandler_DeleteOutcome_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodDelete, "/outcomes/1", nil)
 
@@ -1149,7 +1385,7 @@ func TestOutcomeHandler_DeleteOutcome_NoAuthContext(t *testing.T) {
 
 func TestOutcomeHandler_DeleteOutcomeById_InvalidId(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodDelete, "/outcomes/invalid", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -1172,7 +1408,7 @@ func TestOutcomeHandler_DeleteOutcomeById_InvalidId(t *testing.T) {
 
 func TestOutcomeHandler_DeleteOutcomeById_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1189,14 +1425,37 @@ func TestOutcomeHandler_DeleteOutcomeById_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_DeleteOutcomeById_NotFound(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("DeleteById", ctx, 99, userId).Return(&domain.EntityNotFoundError{UnderlyingCause: errors.New("not found")})
+
+	req := httptest.NewRequest(http.MethodDelete, "/outcomes/99", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "99")
+	w := httptest.NewRecorder()
+
+	handler.DeleteOutcomeById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
 func TestOutcomeHandler_DeleteOutcomeById_ServiceError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1219,7 +1478,7 @@ func TestOutcomeHandler_DeleteOutcomeById_ServiceError(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSum_Success_NoFilters(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1252,9 +1511,86 @@ func TestOutcomeHandler_GetOutcomesSum_Success_NoFilters(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestOutcomeHandler_GetOutcomesSum_WithCount(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetSum", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId).Return([]domain.CategorySum{
+		{CategoryId: 1, Total: 3000, Count: 2},
+		{CategoryId: 2, Total: 0, Count: 0},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?withCount=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesSum(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"categoryId":1,"total":3000,"count":2},{"categoryId":2,"total":0,"count":0}]`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesSum_WithoutCount(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetSum", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId).Return([]domain.CategorySum{
+		{CategoryId: 1, Total: 3000, Count: 2},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesSum(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"categoryId":1,"total":3000}]`, w.Body.String())
+}
+
+func TestOutcomeHandler_GetOutcomesSum_Signed(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetSum", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId).Return([]domain.CategorySum{
+		{CategoryId: 1, Total: 3000},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?signed=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesSum(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"categoryId":1,"total":-3000}]`, w.Body.String())
+}
+
+func TestOutcomeHandler_GetOutcomesSum_InvalidWithCount(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?withCount=maybe", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesSum(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetSum")
+}
+
 func TestOutcomeHandler_GetOutcomesSum_Success_WithFilters(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1288,7 +1624,7 @@ func TestOutcomeHandler_GetOutcomesSum_Success_WithFilters(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSum_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category", nil)
 
@@ -1307,7 +1643,7 @@ func TestOutcomeHandler_GetOutcomesSum_NoAuthContext(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSum_DefaultCurrentMonth(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1347,7 +1683,7 @@ func TestOutcomeHandler_GetOutcomesSum_DefaultCurrentMonth(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSum_InvalidFromDate(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?from=invalid-date", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -1369,7 +1705,7 @@ func TestOutcomeHandler_GetOutcomesSum_InvalidFromDate(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSum_InvalidToDate(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?to=invalid-date", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -1391,7 +1727,7 @@ func TestOutcomeHandler_GetOutcomesSum_InvalidToDate(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSum_InvalidCategory(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?categoryId=invalid", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -1411,23 +1747,40 @@ func TestOutcomeHandler_GetOutcomesSum_InvalidCategory(t *testing.T) {
 	mockService.AssertNotCalled(t, "GetSum", mock.Anything, mock.Anything, mock.Anything, mock.Anything, 123)
 }
 
-func TestOutcomeHandler_GetOutcomesSum_InvalidDateError(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesSum_ZeroCategory(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
-
-	userId := 123
-	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	invalidDatesErr := &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")}
-	mockService.On("GetSum", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId).Return(nil, invalidDatesErr)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?from=2026-01-01T00:00:00Z&to=2025-01-01T00:00:00Z", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?categoryId=0", nil)
+	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
 	handler.GetOutcomesSum(w, req)
 
-	resp := w.Result()
-	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid category")
+
+	mockService.AssertNotCalled(t, "GetSum")
+}
+
+func TestOutcomeHandler_GetOutcomesSum_InvalidDateError(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	invalidDatesErr := &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")}
+	mockService.On("GetSum", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId).Return(nil, invalidDatesErr)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?from=2026-01-01T00:00:00Z&to=2025-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesSum(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
 
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 
@@ -1439,7 +1792,7 @@ func TestOutcomeHandler_GetOutcomesSum_InvalidDateError(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSum_InvalidEntityError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1455,7 +1808,7 @@ func TestOutcomeHandler_GetOutcomesSum_InvalidEntityError(t *testing.T) {
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	assert.Contains(t, string(bodyBytes), "invalid category")
@@ -1465,7 +1818,7 @@ func TestOutcomeHandler_GetOutcomesSum_InvalidEntityError(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSum_ServiceError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1487,7 +1840,7 @@ func TestOutcomeHandler_GetOutcomesSum_ServiceError(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSeries_Success_NoFilters(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1536,7 +1889,7 @@ func TestOutcomeHandler_GetOutcomesSeries_Success_NoFilters(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSeries_Success_WithFilters(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1575,7 +1928,7 @@ func TestOutcomeHandler_GetOutcomesSeries_Success_WithFilters(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSeries_NoAuthContext(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-by-category", nil)
 
@@ -1594,7 +1947,7 @@ func TestOutcomeHandler_GetOutcomesSeries_NoAuthContext(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSeries_DefaultLast12Months(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1631,7 +1984,7 @@ func TestOutcomeHandler_GetOutcomesSeries_DefaultLast12Months(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSeries_InvalidFromDate(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-by-category?from=invalid-date", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -1653,7 +2006,7 @@ func TestOutcomeHandler_GetOutcomesSeries_InvalidFromDate(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSeries_InvalidToDate(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-by-category?to=invalid-date", nil)
 	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
@@ -1675,7 +2028,7 @@ func TestOutcomeHandler_GetOutcomesSeries_InvalidToDate(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSeries_InvalidDateError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1701,7 +2054,7 @@ func TestOutcomeHandler_GetOutcomesSeries_InvalidDateError(t *testing.T) {
 
 func TestOutcomeHandler_GetOutcomesSeries_ServiceError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
@@ -1721,377 +2074,727 @@ func TestOutcomeHandler_GetOutcomesSeries_ServiceError(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotal_Success_NoFilters(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesAverageByCategory_Success(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	expectedTotal := 4500
-	mockService.On("GetTotal", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(expectedTotal, nil)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetAverageByCategory", ctx, &from, &to, userId).Return([]domain.CategoryAverage{
+		{CategoryId: 1, Average: 250, Count: 8},
+		{CategoryId: 2, Average: 0, Count: 0},
+	}, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/total", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/avg-by-category?from=2026-01-01T00:00:00Z&to=2026-02-01T00:00:00Z", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotal(w, req)
+	handler.GetOutcomesAverageByCategory(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-	var data TotalOutcomeResponse
+	var data AverageOutcomeResponse
 	err := json.NewDecoder(resp.Body).Decode(&data)
 	assert.NoError(t, err)
-	assert.Equal(t, expectedTotal, data.Total)
+	assert.Equal(t, AverageOutcomeResponse{
+		{CategoryId: 1, Average: 250, Count: 8},
+		{CategoryId: 2, Average: 0, Count: 0},
+	}, data)
 
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotal_Success_WithFilters(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesAverageByCategory_InvalidDates(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
-	expectedTotal := 3000
-	mockService.On("GetTotal", ctx, &from, &to, userId).Return(expectedTotal, nil)
+	mockService.On("GetAverageByCategory", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).
+		Return(nil, &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")})
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?from=2025-01-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/avg-by-category?from=2026-02-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotal(w, req)
+	handler.GetOutcomesAverageByCategory(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 
-	var data TotalOutcomeResponse
-	err := json.NewDecoder(resp.Body).Decode(&data)
-	assert.NoError(t, err)
-	assert.Equal(t, expectedTotal, data.Total)
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesFrequentNames_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetFrequentNames", ctx, &from, &to, userId, 3).Return([]domain.NameFrequency{
+		{Name: "Bakery", Count: 12, Total: 3600},
+		{Name: "Supermarket", Count: 4, Total: 32000},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/frequent?from=2026-01-01T00:00:00Z&to=2026-02-01T00:00:00Z&limit=3", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesFrequentNames(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[
+		{"name": "Bakery", "count": 12, "total": 3600},
+		{"name": "Supermarket", "count": 4, "total": 32000}
+	]`, w.Body.String())
 
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotal_NoAuthContext(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesFrequentNames_DefaultLimit(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/total", nil)
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetFrequentNames", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 123, domain.DefaultFrequentLimit).
+		Return(nil, nil)
 
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/frequent", nil)
+	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
-	handler.GetOutcomesTotal(w, req)
 
-	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	handler.GetOutcomesFrequentNames(w, req)
 
-	var response ErrorResponse
-	err := json.Unmarshal(w.Body.Bytes(), &response)
-	assert.NoError(t, err)
-	assert.Equal(t, "user not authenticated", response.Message)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[]`, w.Body.String())
+	mockService.AssertExpectations(t)
+}
 
-	mockService.AssertNotCalled(t, "GetOutcomesTotal")
+func TestOutcomeHandler_GetOutcomesFrequentNames_InvalidLimit(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/frequent?limit=101", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesFrequentNames(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetFrequentNames")
 }
 
-func TestOutcomeHandler_GetOutcomesTotal_DefaultCurrentMonth(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesHistogram_Success(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	expectedTotal := 2500
-	mockService.On("GetTotal", ctx, mock.MatchedBy(func(t *time.Time) bool {
-		now := time.Now()
-		expected := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-		return t.Equal(expected)
-	}), mock.MatchedBy(func(t *time.Time) bool {
-		now := time.Now()
-		diff := now.Sub(*t)
-		return diff >= 0 && diff < time.Second
-	}), userId).Return(expectedTotal, nil)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	upper := 1000
+	mockService.On("GetHistogram", ctx, &from, &to, []int{0, 1000}, userId).Return([]domain.AmountBucket{
+		{Min: 0, Max: &upper, Count: 2, Total: 1500},
+		{Min: 1000, Count: 1, Total: 4200},
+	}, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/total", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/histogram?from=2026-01-01T00:00:00Z&to=2026-02-01T00:00:00Z&buckets=0,1000", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotal(w, req)
+	handler.GetOutcomesHistogram(w, req)
 
-	resp := w.Result()
-	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[
+		{"min": 0, "max": 1000, "count": 2, "total": 1500},
+		{"min": 1000, "max": null, "count": 1, "total": 4200}
+	]`, w.Body.String())
 
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mockService.AssertExpectations(t)
+}
 
-	var data TotalOutcomeResponse
-	err := json.NewDecoder(resp.Body).Decode(&data)
-	assert.NoError(t, err)
-	assert.Equal(t, expectedTotal, data.Total)
+func TestOutcomeHandler_GetOutcomesHistogram_DefaultBuckets(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetHistogram", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), domain.DefaultHistogramBuckets, 123).
+		Return([]domain.AmountBucket{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/histogram", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
 
+	handler.GetOutcomesHistogram(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotal_InvalidFromDate(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesHistogram_InvalidBuckets(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?from=invalid-date", nil)
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/histogram?buckets=0,ten", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotal(w, req)
+	handler.GetOutcomesHistogram(w, req)
 
-	resp := w.Result()
-	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetHistogram")
+}
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+func TestOutcomeHandler_GetOutcomesHistogram_BucketsNotAscending(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "invalid 'from' date format")
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetHistogram", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), []int{5000, 1000}, 123).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("bucket bounds must be in ascending order")})
 
-	mockService.AssertNotCalled(t, "GetTotal", mock.Anything, mock.Anything, mock.Anything)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/histogram?buckets=5000,1000", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesHistogram(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotal_InvalidToDate(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesOutliers_Success_DefaultFactor(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?to=invalid-date", nil)
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetOutliers", ctx, &from, &to, 2.0, userId).Return([]domain.OutcomeOutlier{
+		{Outcome: domain.Outcome{ID: 9, Name: "TV", Amount: 89900, CategoryId: 3, CreatedAt: &createdAt}, CategoryAverage: 12000},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/outliers?from=2026-01-01T00:00:00Z&to=2026-02-01T00:00:00Z", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotal(w, req)
+	handler.GetOutcomesOutliers(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "invalid 'to' date format")
+	var data []OutlierOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Len(t, data, 1)
+	assert.Equal(t, 9, data[0].ID)
+	assert.Equal(t, 89900, data[0].Amount)
+	assert.Equal(t, 12000, data[0].CategoryAverage)
 
-	mockService.AssertNotCalled(t, "GetTotal", mock.Anything, mock.Anything, mock.Anything)
+	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotal_InvalidDateError(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesOutliers_CustomFactor(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	invalidDatesErr := &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")}
-	mockService.On("GetTotal", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(0, invalidDatesErr)
+	mockService.On("GetOutliers", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 3.5, userId).
+		Return([]domain.OutcomeOutlier{}, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?from=2026-01-01T00:00:00Z&to=2025-01-01T00:00:00Z", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/outliers?factor=3.5", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotal(w, req)
+	handler.GetOutcomesOutliers(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
-
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
 	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "start date must be before end date")
+	assert.JSONEq(t, "[]", string(bodyBytes))
 
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotal_ServiceError(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesOutliers_InvalidFactorFormat(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
-
-	userId := 123
-	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	mockService.On("GetTotal", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(0, assert.AnError)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/total", nil)
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/outliers?factor=big", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotal(w, req)
+	handler.GetOutcomesOutliers(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mockService.AssertNotCalled(t, "GetOutliers")
+}
 
-	mockService.AssertExpectations(t)
+func TestOutcomeHandler_GetOutcomesOutliers_NonFiniteFactor(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	for _, factor := range []string{"NaN", "Inf", "+Inf", "-Inf", "1e400"} {
+		req := httptest.NewRequest(http.MethodGet, "/outcomes/outliers?factor="+url.QueryEscape(factor), nil)
+		req = req.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		handler.GetOutcomesOutliers(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, factor)
+	}
+	mockService.AssertNotCalled(t, "GetOutliers")
 }
 
-func TestOutcomeHandler_GetOutcomesTotalSeries_Success_NoFilters(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesOutliers_FactorTooSmall(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	expectedSeries := []domain.MonthlyTotalSeries{
-		{
-			Month: "2025-07",
-			Total: 3000,
-		},
-		{
-			Month: "2025-08",
-			Total: 2500,
-		},
-	}
-	mockService.On("GetTotalSeries", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(expectedSeries, nil)
+	mockService.On("GetOutliers", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0.5, userId).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("factor must be at least 1")})
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/outliers?factor=0.5", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotalSeries(w, req)
+	handler.GetOutcomesOutliers(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
-
-	var data []domain.MonthlyTotalSeries
-	err := json.NewDecoder(resp.Body).Decode(&data)
-	assert.NoError(t, err)
-	assert.Len(t, data, 2)
-	assert.Equal(t, "2025-07", data[0].Month)
-	assert.Equal(t, 3000, data[0].Total)
-	assert.Equal(t, "2025-08", data[1].Month)
-	assert.Equal(t, 2500, data[1].Total)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotalSeries_Success_WithFilters(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesForecast_Success(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
-	expectedSeries := []domain.MonthlyTotalSeries{
-		{
-			Month: "2025-01",
-			Total: 3000,
-		},
-	}
-	mockService.On("GetTotalSeries", ctx, &from, &to, userId).Return(expectedSeries, nil)
+	mockService.On("GetForecast", ctx, mock.AnythingOfType("time.Time"), 3, userId).Return([]domain.MonthlyForecast{
+		{Month: "2026-08", Categories: map[int]int{1: 600, 2: 150}, Total: 750},
+		{Month: "2026-09", Categories: map[int]int{1: 600, 2: 150}, Total: 750},
+		{Month: "2026-10", Categories: map[int]int{1: 600, 2: 150}, Total: 750},
+	}, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?from=2025-01-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/forecast", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotalSeries(w, req)
+	handler.GetOutcomesForecast(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-	var data []domain.MonthlyTotalSeries
+	var data ForecastOutcomeResponse
 	err := json.NewDecoder(resp.Body).Decode(&data)
 	assert.NoError(t, err)
-	assert.Len(t, data, 1)
-	assert.Equal(t, "2025-01", data[0].Month)
-	assert.Equal(t, 3000, data[0].Total)
+	assert.Len(t, data, 3)
+	assert.Equal(t, MonthlyForecast{Month: "2026-08", Categories: map[int]int{1: 600, 2: 150}, Total: 750}, data[0])
 
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotalSeries_NoAuthContext(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesForecast_InvalidMonthsFormat(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
-
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total", nil)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/forecast?months=three", nil)
+	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
-	handler.GetOutcomesTotalSeries(w, req)
 
-	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	handler.GetOutcomesForecast(w, req)
 
-	var response ErrorResponse
-	err := json.Unmarshal(w.Body.Bytes(), &response)
-	assert.NoError(t, err)
-	assert.Equal(t, "user not authenticated", response.Message)
+	resp := w.Result()
+	defer resp.Body.Close()
 
-	mockService.AssertNotCalled(t, "GetOutcomesTotalSeries")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mockService.AssertNotCalled(t, "GetForecast")
 }
 
-func TestOutcomeHandler_GetOutcomesTotalSeries_DefaultLast12Months(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesForecast_MonthsOutOfRange(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	expectedSeries := []domain.MonthlyTotalSeries{}
-	mockService.On("GetTotalSeries", ctx, mock.MatchedBy(func(t *time.Time) bool {
-		now := time.Now()
-		expected := now.AddDate(0, -12, 0)
-		diff := expected.Sub(*t)
-		return diff >= 0 && diff < time.Second
-	}), mock.MatchedBy(func(t *time.Time) bool {
-		now := time.Now()
-		diff := now.Sub(*t)
-		return diff >= 0 && diff < time.Second
-	}), userId).Return(expectedSeries, nil)
+	mockService.On("GetForecast", ctx, mock.AnythingOfType("time.Time"), 24, userId).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("months must be between 1 and 12")})
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/forecast?months=24", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotalSeries(w, req)
+	handler.GetOutcomesForecast(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
-
-	var data []domain.MonthlyTotalSeries
-	err := json.NewDecoder(resp.Body).Decode(&data)
-	assert.NoError(t, err)
-	assert.Len(t, data, 0)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
 
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotalSeries_InvalidFromDate(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesStreaks_Success(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?from=invalid-date", nil)
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+	mockService.On("GetStreaks", ctx, from, to, time.UTC, userId).Return(&domain.SpendingStreaks{
+		LongestSpending: domain.Streak{Days: 3, From: "2026-01-01", To: "2026-01-03"},
+		LongestNoSpend:  domain.Streak{Days: 5, From: "2026-01-20", To: "2026-01-24"},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/streaks?from=2026-01-01T00:00:00Z&to=2026-01-31T23:59:59Z", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotalSeries(w, req)
+	handler.GetOutcomesStreaks(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 	bodyBytes, _ := io.ReadAll(resp.Body)
-	assert.Contains(t, string(bodyBytes), "invalid 'from' date format")
+	assert.JSONEq(t, `{
+		"longestSpending": {"days": 3, "from": "2026-01-01", "to": "2026-01-03"},
+		"longestNoSpend": {"days": 5, "from": "2026-01-20", "to": "2026-01-24"}
+	}`, string(bodyBytes))
 
-	mockService.AssertNotCalled(t, "GetTotalSeries", mock.Anything, mock.Anything, mock.Anything, 123)
+	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotalSeries_InvalidToDate(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesStreaks_RangeTooLong(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?to=invalid-date", nil)
-	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetStreaks", ctx, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), time.UTC, userId).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("range must span at most 3660 days")})
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/streaks?from=0001-01-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotalSeries(w, req)
+	handler.GetOutcomesStreaks(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesStreaks_InvalidDates(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetStreaks", ctx, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), time.UTC, userId).
+		Return(nil, &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")})
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/streaks?from=2026-02-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesStreaks(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesGrouped_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 6, 30, 23, 59, 59, 0, time.UTC)
+	mockService.On("GetGroupedSums", ctx, from, to, domain.IntervalQuarter, time.UTC, userId).Return([]domain.PeriodSum{
+		{Start: "2026-01-01", Total: 4500, Count: 3},
+		{Start: "2026-04-01", Total: 0, Count: 0},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/grouped?interval=quarter&from=2026-01-01T00:00:00Z&to=2026-06-30T23:59:59Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesGrouped(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.JSONEq(t, `[
+		{"start": "2026-01-01", "total": 4500, "count": 3},
+		{"start": "2026-04-01", "total": 0, "count": 0}
+	]`, string(bodyBytes))
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesGrouped_DefaultsToMonth(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetGroupedSums", ctx, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), domain.IntervalMonth, time.UTC, userId).
+		Return([]domain.PeriodSum{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/grouped", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesGrouped(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[]`, w.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesGrouped_InvalidInterval(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetGroupedSums", ctx, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), domain.Interval("hour"), time.UTC, userId).
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("interval must be one of day, week, month, quarter or year")})
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/grouped?interval=hour", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesGrouped(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_Success_NoFilters(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	expectedTotal := 4500
+	mockService.On("GetTotal", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(expectedTotal, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data TotalOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTotal, data.Total)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_Signed(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetTotal", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(4500, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?signed=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"total":-4500}`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_InvalidSigned(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?signed=maybe", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetTotal")
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_Success_WithFilters(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedTotal := 3000
+	mockService.On("GetTotal", ctx, &from, &to, userId).Return(expectedTotal, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?from=2025-01-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data TotalOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTotal, data.Total)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_NoAuthContext(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total", nil)
+
+	w := httptest.NewRecorder()
+	handler.GetOutcomesTotal(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "user not authenticated", response.Message)
+
+	mockService.AssertNotCalled(t, "GetOutcomesTotal")
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_DefaultCurrentMonth(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	expectedTotal := 2500
+	mockService.On("GetTotal", ctx, mock.MatchedBy(func(t *time.Time) bool {
+		now := time.Now()
+		expected := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return t.Equal(expected)
+	}), mock.MatchedBy(func(t *time.Time) bool {
+		now := time.Now()
+		diff := now.Sub(*t)
+		return diff >= 0 && diff < time.Second
+	}), userId).Return(expectedTotal, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data TotalOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTotal, data.Total)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_InvalidFromDate(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?from=invalid-date", nil)
+	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(bodyBytes), "invalid 'from' date format")
+
+	mockService.AssertNotCalled(t, "GetTotal", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_InvalidToDate(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?to=invalid-date", nil)
+	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
@@ -2101,23 +2804,23 @@ func TestOutcomeHandler_GetOutcomesTotalSeries_InvalidToDate(t *testing.T) {
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	assert.Contains(t, string(bodyBytes), "invalid 'to' date format")
 
-	mockService.AssertNotCalled(t, "GetTotalSeries", mock.Anything, mock.Anything, mock.Anything, 123)
+	mockService.AssertNotCalled(t, "GetTotal", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestOutcomeHandler_GetOutcomesTotalSeries_InvalidDateError(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesTotal_InvalidDateError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
 	invalidDatesErr := &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")}
-	mockService.On("GetTotalSeries", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(nil, invalidDatesErr)
+	mockService.On("GetTotal", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(0, invalidDatesErr)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?from=2026-01-01T00:00:00Z&to=2025-01-01T00:00:00Z", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?from=2026-01-01T00:00:00Z&to=2025-01-01T00:00:00Z", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotalSeries(w, req)
+	handler.GetOutcomesTotal(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
@@ -2130,19 +2833,19 @@ func TestOutcomeHandler_GetOutcomesTotalSeries_InvalidDateError(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
-func TestOutcomeHandler_GetOutcomesTotalSeries_ServiceError(t *testing.T) {
+func TestOutcomeHandler_GetOutcomesTotal_ServiceError(t *testing.T) {
 	mockService := new(mocks.OutcomeService)
-	handler := NewOutcomeHandler(mockService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
 
 	userId := 123
 	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
-	mockService.On("GetTotalSeries", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(nil, assert.AnError)
+	mockService.On("GetTotal", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(0, assert.AnError)
 
-	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total", nil)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total", nil)
 	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
-	handler.GetOutcomesTotalSeries(w, req)
+	handler.GetOutcomesTotal(w, req)
 
 	resp := w.Result()
 	defer resp.Body.Close()
@@ -2151,3 +2854,1200 @@ func TestOutcomeHandler_GetOutcomesTotalSeries_ServiceError(t *testing.T) {
 
 	mockService.AssertExpectations(t)
 }
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_Success_NoFilters(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	expectedSeries := []domain.MonthlyTotalSeries{
+		{
+			Month: "2025-07",
+			Total: 3000,
+		},
+		{
+			Month: "2025-08",
+			Total: 2500,
+		},
+	}
+	mockService.On("GetTotalSeries", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(expectedSeries, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotalSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data []domain.MonthlyTotalSeries
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Len(t, data, 2)
+	assert.Equal(t, "2025-07", data[0].Month)
+	assert.Equal(t, 3000, data[0].Total)
+	assert.Equal(t, "2025-08", data[1].Month)
+	assert.Equal(t, 2500, data[1].Total)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_Success_WithFilters(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedSeries := []domain.MonthlyTotalSeries{
+		{
+			Month: "2025-01",
+			Total: 3000,
+		},
+	}
+	mockService.On("GetTotalSeries", ctx, &from, &to, userId).Return(expectedSeries, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?from=2025-01-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotalSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data []domain.MonthlyTotalSeries
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Len(t, data, 1)
+	assert.Equal(t, "2025-01", data[0].Month)
+	assert.Equal(t, 3000, data[0].Total)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesMonthlyCounts_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetMonthlyCounts", ctx, &from, &to, userId).Return([]domain.MonthlyCount{
+		{Month: "2025-01", Count: 12},
+		{Month: "2025-02", Count: 0},
+		{Month: "2025-03", Count: 3},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/monthly-counts?from=2025-01-01T00:00:00Z&to=2025-03-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesMonthlyCounts(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"month":"2025-01","count":12},{"month":"2025-02","count":0},{"month":"2025-03","count":3}]`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesMonthlyCounts_InvalidTo(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/monthly-counts?to=2025-03", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesMonthlyCounts(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetMonthlyCounts")
+}
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_NoAuthContext(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total", nil)
+
+	w := httptest.NewRecorder()
+	handler.GetOutcomesTotalSeries(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "user not authenticated", response.Message)
+
+	mockService.AssertNotCalled(t, "GetOutcomesTotalSeries")
+}
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_DefaultLast12Months(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	expectedSeries := []domain.MonthlyTotalSeries{}
+	mockService.On("GetTotalSeries", ctx, mock.MatchedBy(func(t *time.Time) bool {
+		now := time.Now()
+		expected := now.AddDate(0, -12, 0)
+		diff := expected.Sub(*t)
+		return diff >= 0 && diff < time.Second
+	}), mock.MatchedBy(func(t *time.Time) bool {
+		now := time.Now()
+		diff := now.Sub(*t)
+		return diff >= 0 && diff < time.Second
+	}), userId).Return(expectedSeries, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotalSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data []domain.MonthlyTotalSeries
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Len(t, data, 0)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_InvalidFromDate(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?from=invalid-date", nil)
+	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotalSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(bodyBytes), "invalid 'from' date format")
+
+	mockService.AssertNotCalled(t, "GetTotalSeries", mock.Anything, mock.Anything, mock.Anything, 123)
+}
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_InvalidToDate(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?to=invalid-date", nil)
+	ctx := auth.ContextWithUserIDForTests(req.Context(), 123)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotalSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(bodyBytes), "invalid 'to' date format")
+
+	mockService.AssertNotCalled(t, "GetTotalSeries", mock.Anything, mock.Anything, mock.Anything, 123)
+}
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_InvalidDateError(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	invalidDatesErr := &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")}
+	mockService.On("GetTotalSeries", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(nil, invalidDatesErr)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?from=2026-01-01T00:00:00Z&to=2025-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotalSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(bodyBytes), "start date must be before end date")
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_ServiceError(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetTotalSeries", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), userId).Return(nil, assert.AnError)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotalSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesNewCategories_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	month := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetNewCategories", ctx, month, userId).Return([]domain.CategorySum{
+		{CategoryId: 2, Total: 1200},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/new-categories?month=2026-03", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesNewCategories(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"categoryId":2,"total":1200}]`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesNewCategories_InvalidMonth(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/new-categories?month=2026-13", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesNewCategories(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid 'month' format")
+	mockService.AssertNotCalled(t, "GetNewCategories")
+}
+
+func TestOutcomeHandler_GetOutcomesYearOverYear_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	month := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetYearOverYear", ctx, month, userId).Return(&domain.YearOverYear{
+		Month:         "2026-03",
+		Total:         5000,
+		PreviousMonth: "2025-03",
+		Delta:         5000,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/year-over-year?month=2026-03", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesYearOverYear(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"month":"2026-03","total":5000,"previousMonth":"2025-03","previousTotal":0,"delta":5000,"percent":null}`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesYearOverYear_InvalidMonth(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/year-over-year?month=03-2026", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesYearOverYear(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetYearOverYear")
+}
+
+func TestOutcomeHandler_ExportOutcomesXLSX_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := endOfMonth(from)
+	createdAt := time.Date(2026, 3, 14, 12, 30, 0, 0, time.UTC)
+	mockService.On("Export", ctx, &from, &to, 2, userId).Return([]domain.ExportedOutcome{
+		{Outcome: domain.Outcome{ID: 1, Name: "Restaurant", Amount: 1999, CategoryId: 2, CreatedAt: &createdAt}, CategoryLabel: "Food"},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/export.xlsx?month=2026-03&categoryId=2", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.ExportOutcomesXLSX(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="outcomes.xlsx"`, w.Header().Get("Content-Disposition"))
+
+	workbook, err := excelize.OpenReader(w.Body)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer workbook.Close()
+
+	rows, err := workbook.GetRows("Outcomes")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Date", "Name", "Category", "Amount"},
+		{"2026-03-14 12:30", "Restaurant", "Food", "19.99"},
+	}, rows)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_ExportOutcomesXLSX_InvalidCategory(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("Export", ctx, mock.Anything, mock.Anything, 99, userId).Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("invalid category")})
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/export.xlsx?categoryId=99", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.ExportOutcomesXLSX(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+}
+
+func TestOutcomeHandler_ExportOutcomesXLSX_TooManyOutcomes(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("Export", ctx, mock.Anything, mock.Anything, 0, userId).Return(nil, &domain.InvalidDateError{UnderlyingCause: errors.New("cannot export more than 50000 outcomes, narrow the date range")})
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/export.xlsx?from=2000-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.ExportOutcomesXLSX(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+}
+
+func TestOutcomeHandler_ExportOutcomesXLSX_InvalidFrom(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/export.xlsx?from=2026-03-01", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.ExportOutcomesXLSX(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "Export")
+}
+
+func TestOutcomeHandler_GetOutcomesDateBounds_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	minDate := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2026, 9, 30, 18, 30, 0, 0, time.UTC)
+	mockService.On("GetDateBounds", ctx, userId).Return(&domain.DateBounds{Min: minDate, Max: maxDate}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/date-bounds", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesDateBounds(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data DateBoundsResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.True(t, minDate.Equal(data.Min))
+	assert.True(t, maxDate.Equal(data.Max))
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesDateBounds_NoContent(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetDateBounds", ctx, userId).Return(nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/date-bounds", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesDateBounds(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetAllOutcomes_Month(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, location, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, location)
+	to := time.Date(2025, 3, 31, 23, 59, 59, 999999999, location)
+	mockService.On("GetAll", ctx, &from, &to, 0, userId, 20, 0).Return([]domain.Outcome{}, 0, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?month=2025-03", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetAllOutcomes_MonthWithDates(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?month=2025-03&from=2025-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(bodyBytes), "'month' cannot be combined with 'from' or 'to'")
+
+	mockService.AssertNotCalled(t, "GetAll")
+}
+
+func TestOutcomeHandler_GetAllOutcomes_InvalidMonth(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?month=2025-13", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	mockService.AssertNotCalled(t, "GetAll")
+}
+
+func TestOutcomeHandler_GetAllOutcomes_DefaultMonthInLocation(t *testing.T) {
+	location, err := time.LoadLocation("Pacific/Kiritimati")
+	assert.NoError(t, err)
+
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, location, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	// The current month starts at midnight in the configured timezone, whatever
+	// the timezone of the server
+	mockService.On("GetAll", ctx, mock.MatchedBy(func(t *time.Time) bool {
+		now := time.Now().In(location)
+		return t.Equal(time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location))
+	}), mock.AnythingOfType("*time.Time"), 0, userId, 20, 0).Return([]domain.Outcome{}, 0, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_Month(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC)
+	mockService.On("GetTotal", ctx, &from, &to, userId).Return(4200, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?month=2024-02", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data TotalOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, 4200, data.Total)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesSum_MonthWithDates(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?month=2025-03&to=2025-03-15T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesSum(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	mockService.AssertNotCalled(t, "GetSum")
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_AllTime(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetTotal", ctx, (*time.Time)(nil), (*time.Time)(nil), userId).Return(987654, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?allTime=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data TotalOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, 987654, data.Total)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesSum_AllTime(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetSum", ctx, (*time.Time)(nil), (*time.Time)(nil), 0, userId).Return([]domain.CategorySum{
+		{CategoryId: 1, Total: 50000},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/sums-by-category?allTime=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesSum(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_AllTimeWithDates(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?allTime=true&from=2025-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(bodyBytes), "'allTime' cannot be combined with date filters")
+
+	mockService.AssertNotCalled(t, "GetTotal")
+}
+
+func TestOutcomeHandler_GetOutcomesTotal_InvalidAllTime(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/total?allTime=maybe", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotal(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	mockService.AssertNotCalled(t, "GetTotal")
+}
+
+func TestOutcomeHandler_GetOutcomesSeries_AllTimeRejected(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-by-category?allTime=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	mockService.AssertNotCalled(t, "GetSeries")
+}
+
+func TestOutcomeHandler_GetOutcomesProjection_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetProjection", ctx, mock.AnythingOfType("time.Time"), userId).Return(&domain.SpendingProjection{
+		SpentSoFar:     45000,
+		DaysElapsed:    15,
+		DaysInMonth:    30,
+		ProjectedTotal: 90000,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/projection", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesProjection(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data ProjectionOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, ProjectionOutcomeResponse{SpentSoFar: 45000, DaysElapsed: 15, DaysInMonth: 30, ProjectedTotal: 90000}, data)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_BulkPatchOutcomes_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("BulkPatch", ctx, []domain.OutcomePatch{
+		{ID: 1, CategoryId: 2},
+		{ID: 2, Name: "Train"},
+	}, userId).Return([]domain.Outcome{
+		{ID: 1, Name: "Lunch", Amount: 1200, CategoryId: 2},
+		{ID: 2, Name: "Train", Amount: 4500, CategoryId: 1},
+	}, nil)
+
+	body := `[{"id": 1, "categoryId": 2}, {"id": 2, "name": " Train "}]`
+	req := httptest.NewRequest(http.MethodPatch, "/outcomes/bulk", bytes.NewBufferString(body))
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.BulkPatchOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data []OutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Len(t, data, 2)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_BulkPatchOutcomes_ItemErrors(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("BulkPatch", ctx, mock.Anything, userId).Return(nil, &domain.BulkError{
+		Errors: []domain.IndexedError{
+			{Index: 1, Err: &domain.EntityNotFoundError{UnderlyingCause: errors.New("no rows in result set")}},
+		},
+	})
+
+	body := `[{"id": 1, "amount": 100}, {"id": 99, "amount": 200}]`
+	req := httptest.NewRequest(http.MethodPatch, "/outcomes/bulk", bytes.NewBufferString(body))
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.BulkPatchOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	var data BulkErrorResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, []ItemErrorResponse{{Index: 1, Message: "entity not found: no rows in result set"}}, data.Errors)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_BulkPatchOutcomes_Empty(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodPatch, "/outcomes/bulk", bytes.NewBufferString(`[]`))
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.BulkPatchOutcomes(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "BulkPatch")
+}
+
+func TestOutcomeHandler_BulkPatchOutcomes_TooManyItems(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, 2)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodPatch, "/outcomes/bulk", bytes.NewBufferString(`[{"id": 1}, {"id": 2}, {"id": 3}]`))
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.BulkPatchOutcomes(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"message": "at most 2 items are allowed"}`, w.Body.String())
+	mockService.AssertNotCalled(t, "BulkPatch")
+}
+
+func TestOutcomeHandler_BulkPatchOutcomes_NotAnArray(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodPatch, "/outcomes/bulk", bytes.NewBufferString(`{"id": 1}`))
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.BulkPatchOutcomes(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "BulkPatch")
+}
+
+func TestOutcomeHandler_GetOutcomesByHour_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	sums := make([]domain.HourlySum, 24)
+	for hour := range sums {
+		sums[hour].Hour = hour
+	}
+	sums[12].Total = 2500
+	mockService.On("GetSumByHour", ctx, &from, &to, "Europe/Paris", userId).Return(sums, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/by-hour?from=2026-01-01T00:00:00Z&to=2026-02-01T00:00:00Z&tz=Europe/Paris", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesByHour(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data ByHourOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Len(t, data, 24)
+	assert.Equal(t, HourlySumResponse{Hour: 12, Total: 2500}, data[12])
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesByHour_DefaultsToHandlerTimezone(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetSumByHour", ctx, mock.Anything, mock.Anything, "UTC", userId).Return([]domain.HourlySum{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/by-hour", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesByHour(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesByHour_InvalidTimezone(t *testing.T) {
+	for _, tz := range []string{"Mars/Olympus", "Local"} {
+		t.Run(tz, func(t *testing.T) {
+			mockService := new(mocks.OutcomeService)
+			handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+			ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+			req := httptest.NewRequest(http.MethodGet, "/outcomes/by-hour?tz="+tz, nil)
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.GetOutcomesByHour(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockService.AssertNotCalled(t, "GetSumByHour")
+		})
+	}
+}
+
+func TestOutcomeHandler_GetOutcomeById_Signed(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetById", ctx, 1, userId).Return(&domain.Outcome{ID: 1, Name: "Restaurant", Amount: 1999, CategoryId: 1, UserId: userId}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/1?signed=true", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomeById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data OutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, -1999, data.Amount)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomeById_InvalidSigned(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/1?signed=maybe", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomeById(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetById")
+}
+
+func TestOutcomeHandler_GetOutcomesTotalSeries_Signed(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetTotalSeries", ctx, &from, &to, userId).Return([]domain.MonthlyTotalSeries{
+		{Month: "2026-01", Total: 12000},
+		{Month: "2026-02", Total: 0},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/series-total?from=2026-01-01T00:00:00Z&to=2026-02-28T00:00:00Z&signed=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesTotalSeries(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data TotalSeriesOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, TotalSeriesOutcomeResponse{
+		{Month: "2026-01", Total: -12000},
+		{Month: "2026-02", Total: 0},
+	}, data)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesRolling_Success(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	from := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	mockService.On("GetRollingTotal", ctx, mock.AnythingOfType("time.Time"), 7, userId).Return(&domain.RollingTotal{From: from, To: to, Total: 3100}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/rolling?window=7d", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesRolling(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data RollingOutcomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, RollingOutcomeResponse{Window: "7d", From: from, To: to, Total: 3100}, data)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesRolling_DefaultWindow(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetRollingTotal", ctx, mock.AnythingOfType("time.Time"), domain.DefaultRollingWindowDays, userId).Return(&domain.RollingTotal{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/rolling", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesRolling(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomesRolling_InvalidFormat(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	for _, window := range []string{"30", "2w", "d", "1.5d"} {
+		req := httptest.NewRequest(http.MethodGet, "/outcomes/rolling?window="+window, nil)
+		req = req.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		handler.GetOutcomesRolling(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, window)
+	}
+	mockService.AssertNotCalled(t, "GetRollingTotal")
+}
+
+func TestOutcomeHandler_GetOutcomesRolling_WindowTooLarge(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetRollingTotal", ctx, mock.AnythingOfType("time.Time"), 400, userId).Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("window must be between 1 and 365 days")})
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/rolling?window=400d", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomesRolling(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetAllOutcomes_Fields(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetAll", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, userId, 20, 0).Return([]domain.Outcome{
+		{ID: 1, Name: "Restaurant", Amount: 1999, CategoryId: 1, CreatedAt: &time.Time{}, UserId: userId},
+	}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?fields=id,amount", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data struct {
+		Data       []map[string]any   `json:"data"`
+		Pagination PaginationResponse `json:"pagination"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"id": float64(1), "amount": float64(1999)}}, data.Data)
+	assert.Equal(t, 1, data.Pagination.Total)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetOutcomeById_Fields(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	userId := 123
+	ctx := auth.ContextWithUserIDForTests(context.Background(), userId)
+	mockService.On("GetById", ctx, 1, userId).Return(&domain.Outcome{ID: 1, Name: "Restaurant", Amount: 1999, CategoryId: 1, UserId: userId}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/1?fields=id,amount&signed=true", nil)
+	req = req.WithContext(ctx)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	handler.GetOutcomeById(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data map[string]any
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"id": float64(1), "amount": float64(-1999)}, data)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestOutcomeHandler_GetAllOutcomes_UnknownField(t *testing.T) {
+	mockService := new(mocks.OutcomeService)
+	handler := NewOutcomeHandler(mockService, time.UTC, config.DefaultMaxBulkItems)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/outcomes/?fields=id,userId", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetAllOutcomes(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `unknown field \"userId\"`)
+	mockService.AssertNotCalled(t, "GetAll")
+}
@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kerhael/accounting/internal/domain"
+)
+
+// parseMonth parses a YYYY-MM query value into the first instant of that month.
+func parseMonth(value string, loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation("2006-01", value, loc)
+}
+
+// parseDateRange parses the from and to query values of r (RFC3339), nil when
+// absent. month (YYYY-MM, in loc) may be given instead of them for the whole
+// month. Errors are meant to be answered with a 400.
+func parseDateRange(r *http.Request, loc *time.Location) (*time.Time, *time.Time, error) {
+	query := r.URL.Query()
+	var from, to *time.Time
+
+	if fromStr := query.Get("from"); fromStr != "" {
+		parsedFrom, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			return nil, nil, errors.New("invalid 'from' date format, use ISO 8601 (RFC3339)")
+		}
+		from = &parsedFrom
+	}
+
+	if toStr := query.Get("to"); toStr != "" {
+		parsedTo, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			return nil, nil, errors.New("invalid 'to' date format, use ISO 8601 (RFC3339)")
+		}
+		to = &parsedTo
+	}
+
+	if monthStr := query.Get("month"); monthStr != "" {
+		if from != nil || to != nil {
+			return nil, nil, errors.New("'month' cannot be combined with 'from' or 'to'")
+		}
+		monthStart, err := parseMonth(monthStr, loc)
+		if err != nil {
+			return nil, nil, errors.New("invalid 'month' format, use YYYY-MM")
+		}
+		monthEnd := endOfMonth(monthStart)
+		from = &monthStart
+		to = &monthEnd
+	}
+
+	return from, to, nil
+}
+
+// parseAllTime parses the allTime query value of r, asking for aggregates over
+// all time, which cannot be combined with date filters.
+func parseAllTime(r *http.Request, from *time.Time, to *time.Time) (bool, error) {
+	allTime, err := parseBoolParam(r.URL.Query().Get("allTime"))
+	if err != nil {
+		return false, errors.New("invalid 'allTime' value, use true or false")
+	}
+	if allTime && (from != nil || to != nil) {
+		return false, errors.New("'allTime' cannot be combined with date filters")
+	}
+	return allTime, nil
+}
+
+// endOfMonth returns the last instant of the month starting at monthStart.
+func endOfMonth(monthStart time.Time) time.Time {
+	return monthStart.AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
+
+// parseWindowDays parses a trailing window expressed in days, such as "30d".
+func parseWindowDays(value string) (int, error) {
+	days, ok := strings.CutSuffix(value, "d")
+	if !ok {
+		return 0, fmt.Errorf("invalid window %q", value)
+	}
+	return strconv.Atoi(days)
+}
+
+// parseCursor parses a keyset pagination cursor formatted as "<createdAt>,<id>".
+func parseCursor(value string) (*domain.Cursor, error) {
+	createdAtStr, idStr, ok := strings.Cut(value, ",")
+	if !ok {
+		return nil, fmt.Errorf("invalid cursor %q", value)
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdAtStr)
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("invalid cursor id %q", idStr)
+	}
+	return &domain.Cursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+// formatCursor formats a cursor for the after query parameter, keeping sub-second
+// precision so that no row is skipped.
+func formatCursor(cursor *domain.Cursor) string {
+	return cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "," + strconv.Itoa(cursor.ID)
+}
+
+// parseBoolParam parses an optional boolean query value, an empty value being false.
+func parseBoolParam(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
@@ -0,0 +1,32 @@
+package v1
+
+import "time"
+
+type CategoryIncomeShareResponse struct {
+	CategoryId      int      `json:"categoryId"`      // Category ID
+	Total           int      `json:"total"`           // Total amount in cents spent in this category
+	PercentOfIncome *float64 `json:"percentOfIncome"` // Category spend as a percentage of income (null when there is no income)
+}
+
+type SpendVsIncomeResponse struct {
+	Month       string                        `json:"month"`       // Month in YYYY-MM format
+	Income      int                           `json:"income"`      // Total income in cents
+	Spent       int                           `json:"spent"`       // Total spend in cents
+	SavingsRate *float64                      `json:"savingsRate"` // Percentage of income left after spending (null when there is no income)
+	Categories  []CategoryIncomeShareResponse `json:"categories"`  // Spend of each category
+}
+
+type PayPeriodBalanceResponse struct {
+	From   time.Time `json:"from"`   // Start of the pay period
+	To     time.Time `json:"to"`     // End of the pay period
+	Income int       `json:"income"` // Total income in cents over the pay period
+	Spent  int       `json:"spent"`  // Total spend in cents over the pay period
+	Net    int       `json:"net"`    // Income minus spend in cents
+}
+
+type MonthlySavingsRateResponse struct {
+	Month       string   `json:"month"`       // Month in YYYY-MM format
+	Income      int      `json:"income"`      // Total income in cents
+	Outcome     int      `json:"outcome"`     // Total outcomes in cents
+	SavingsRate *float64 `json:"savingsRate"` // Percentage of income left after spending (null when there is no income)
+}
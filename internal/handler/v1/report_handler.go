@@ -0,0 +1,251 @@
+package v1
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/handler/utils"
+	"github.com/kerhael/accounting/internal/service"
+)
+
+type ReportHandler struct {
+	service  service.ReportServiceInterface
+	location *time.Location
+	renderer StatementRenderer
+}
+
+func NewReportHandler(service service.ReportServiceInterface, location *time.Location) *ReportHandler {
+	return &ReportHandler{service: service, location: location, renderer: pdfStatementRenderer{}}
+}
+
+// Get spend versus income report
+// @Summary      Get spend versus income report
+// @Description Get each category's spend of a month as a percentage of that month's income, along with the overall savings rate. Percentages are null when there is no income.
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        month query     string  false  "Month (YYYY-MM format, in the server timezone, defaults to current month)"
+// @Success      200   {object}   SpendVsIncomeResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /reports/spend-vs-income [get]
+func (h *ReportHandler) GetSpendVsIncome(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	now := time.Now().In(h.location)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, h.location)
+
+	monthStr := r.URL.Query().Get("month")
+	if monthStr != "" {
+		parsedMonth, err := parseMonth(monthStr, h.location)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'month' format, use YYYY-MM")
+			return
+		}
+		monthStart = parsedMonth
+	}
+
+	report, err := h.service.GetSpendVsIncome(r.Context(), monthStart, endOfMonth(monthStart), userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	resp := SpendVsIncomeResponse{
+		Month:       monthStart.Format("2006-01"),
+		Income:      report.Income,
+		Spent:       report.Spent,
+		SavingsRate: report.SavingsRate,
+		Categories:  []CategoryIncomeShareResponse{},
+	}
+	for _, c := range report.Categories {
+		resp.Categories = append(resp.Categories, CategoryIncomeShareResponse{
+			CategoryId:      c.CategoryId,
+			Total:           c.Total,
+			PercentOfIncome: c.PercentOfIncome,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Get pay period balance
+// @Summary      Get pay period balance
+// @Description Get the income minus the outcomes of the pay period containing now. Pay periods are lengthDays long and one of them starts on the anchor day, which suits pay cycles not matching calendar months (ex: biweekly).
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        anchor     query  string  true   "Start day of any pay period (YYYY-MM-DD format, in the server timezone)"
+// @Param        lengthDays query  int     false  "Pay period length in days (defaults to 14, max 365)"
+// @Success      200   {object}   PayPeriodBalanceResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422   {object}   ErrorResponse  "Validation error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /balance/pay-period [get]
+func (h *ReportHandler) GetPayPeriodBalance(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	anchorStr := r.URL.Query().Get("anchor")
+	if anchorStr == "" {
+		utils.WriteJSONError(w, http.StatusBadRequest, "anchor is required")
+		return
+	}
+	anchor, err := time.ParseInLocation("2006-01-02", anchorStr, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'anchor' format, use YYYY-MM-DD")
+		return
+	}
+
+	lengthDays := domain.DefaultPayPeriodDays
+	if lengthStr := r.URL.Query().Get("lengthDays"); lengthStr != "" {
+		lengthDays, err = strconv.Atoi(lengthStr)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'lengthDays' value, use a number of days")
+			return
+		}
+	}
+
+	balance, err := h.service.GetPayPeriodBalance(r.Context(), time.Now().In(h.location), anchor, lengthDays, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, PayPeriodBalanceResponse{
+		From:   balance.From,
+		To:     balance.To,
+		Income: balance.Income,
+		Spent:  balance.Spent,
+		Net:    balance.Net,
+	})
+}
+
+// Get monthly savings rate
+// @Summary      Get monthly savings rate
+// @Description Get the income, the outcomes and the savings rate ((income - outcome) / income, as a percentage) of each month between dates (defaults to last 12 months if not provided). The rate is null for months without income.
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date filter (ISO 8601 format, defaults to 12 months ago)"
+// @Param        to    query     string  false  "End date filter (ISO 8601 format, defaults to now)"
+// @Param        month query     string  false  "Month filter (YYYY-MM format, in the server timezone, cannot be combined with from/to)"
+// @Success      200   {array}   MonthlySavingsRateResponse
+// @Failure      400   {object}   ErrorResponse  "Bad request error"
+// @Failure      401   {object}   ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}   ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /reports/savings-rate [get]
+func (h *ReportHandler) GetSavingsRate(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	from, to, err := parseDateRange(r, h.location)
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// If only one date or no dates provided, default to (last) 12 months
+	if from == nil || to == nil {
+		if from == nil && to == nil {
+			now := time.Now().In(h.location)
+			twelveMonthsAgo := now.AddDate(0, -12, 0)
+			from = &twelveMonthsAgo
+			to = &now
+		} else if from == nil {
+			twelveMonthsAgo := to.AddDate(0, -12, 0)
+			from = &twelveMonthsAgo
+		} else {
+			twelveMonthsAfter := from.AddDate(0, 12, 0)
+			to = &twelveMonthsAfter
+		}
+	}
+
+	rates, err := h.service.GetSavingsRate(r.Context(), *from, *to, userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	resp := make([]MonthlySavingsRateResponse, 0, len(rates))
+	for _, rate := range rates {
+		resp = append(resp, MonthlySavingsRateResponse{
+			Month:       rate.Month,
+			Income:      rate.Income,
+			Outcome:     rate.Outcome,
+			SavingsRate: rate.SavingsRate,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Export a monthly statement as a PDF file
+// @Summary      Export a monthly statement as a PDF file
+// @Description Download a PDF statement of a month, with its income total, outcome total, net and the spend of each category.
+// @Tags         reports
+// @Produce      application/pdf
+// @Param        month query     string  false  "Month (YYYY-MM format, in the server timezone, defaults to current month)"
+// @Success      200   {file}    file
+// @Failure      400   {object}  ErrorResponse  "Bad request error"
+// @Failure      401   {object}  ErrorResponse  "Unauthorized error"
+// @Failure      500   {object}  ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /reports/monthly.pdf [get]
+func (h *ReportHandler) ExportMonthlyStatement(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	now := time.Now().In(h.location)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, h.location)
+
+	monthStr := r.URL.Query().Get("month")
+	if monthStr != "" {
+		parsedMonth, err := parseMonth(monthStr, h.location)
+		if err != nil {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'month' format, use YYYY-MM")
+			return
+		}
+		monthStart = parsedMonth
+	}
+
+	statement, err := h.service.GetMonthlyStatement(r.Context(), monthStart, endOfMonth(monthStart), userId)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	// Built in memory so that a failure can still be answered with an error status
+	var buf bytes.Buffer
+	if err := h.renderer.Render(&buf, monthStart, statement); err != nil {
+		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", h.renderer.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="statement-`+monthStart.Format("2006-01")+`.pdf"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,335 @@
+package v1
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReportHandler_GetSpendVsIncome_Success(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := endOfMonth(from)
+	savingsRate := 65.0
+	percent := 35.0
+	mockService.On("GetSpendVsIncome", ctx, from, to, 123).Return(&domain.SpendVsIncome{
+		Income:      200000,
+		Spent:       70000,
+		SavingsRate: &savingsRate,
+		Categories: []domain.CategoryIncomeShare{
+			{CategoryId: 1, Total: 70000, PercentOfIncome: &percent},
+		},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/spend-vs-income?month=2026-03", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetSpendVsIncome(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data SpendVsIncomeResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-03", data.Month)
+	assert.Equal(t, 200000, data.Income)
+	assert.Equal(t, 65.0, *data.SavingsRate)
+	assert.Equal(t, []CategoryIncomeShareResponse{{CategoryId: 1, Total: 70000, PercentOfIncome: &percent}}, data.Categories)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestReportHandler_GetSpendVsIncome_NoIncomeReturnsNull(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetSpendVsIncome", ctx, from, endOfMonth(from), 123).Return(&domain.SpendVsIncome{
+		Spent: 5000,
+		Categories: []domain.CategoryIncomeShare{
+			{CategoryId: 1, Total: 5000},
+		},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/spend-vs-income?month=2026-03", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetSpendVsIncome(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"month":"2026-03","income":0,"spent":5000,"savingsRate":null,"categories":[{"categoryId":1,"total":5000,"percentOfIncome":null}]}`, w.Body.String())
+}
+
+func TestReportHandler_GetSpendVsIncome_InvalidMonth(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/spend-vs-income?month=2026-13", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetSpendVsIncome(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetSpendVsIncome")
+}
+
+func TestReportHandler_GetSpendVsIncome_ServiceError(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetSpendVsIncome", ctx, from, endOfMonth(from), 123).Return(nil, errors.New("db error"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/spend-vs-income?month=2026-03", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetSpendVsIncome(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestReportHandler_GetSpendVsIncome_NoAuthContext(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/spend-vs-income", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetSpendVsIncome(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestReportHandler_GetPayPeriodBalance_Success(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	anchor := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	from := time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 27, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	mockService.On("GetPayPeriodBalance", ctx, mock.AnythingOfType("time.Time"), anchor, 14, 123).Return(&domain.PayPeriodBalance{
+		From:   from,
+		To:     to,
+		Income: 250000,
+		Spent:  90000,
+		Net:    160000,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/balance/pay-period?anchor=2026-01-02&lengthDays=14", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetPayPeriodBalance(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data PayPeriodBalanceResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.True(t, from.Equal(data.From))
+	assert.Equal(t, 160000, data.Net)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestReportHandler_GetPayPeriodBalance_InvalidParams(t *testing.T) {
+	for _, query := range []string{"", "anchor=02/01/2026", "anchor=2026-01-02&lengthDays=two"} {
+		mockService := new(mocks.ReportService)
+		handler := NewReportHandler(mockService, time.UTC)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/balance/pay-period?"+query, nil)
+		req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+		w := httptest.NewRecorder()
+
+		handler.GetPayPeriodBalance(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		mockService.AssertNotCalled(t, "GetPayPeriodBalance")
+	}
+}
+
+func TestReportHandler_GetPayPeriodBalance_InvalidLength(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetPayPeriodBalance", ctx, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 0, 123).Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("length must be between 1 and 365 days")})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/balance/pay-period?anchor=2026-01-02&lengthDays=0", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetPayPeriodBalance(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestReportHandler_GetSavingsRate_Success(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 28, 23, 59, 59, 0, time.UTC)
+	savingsRate := 64.67
+	mockService.On("GetSavingsRate", ctx, from, to, 123).Return([]domain.MonthlySavingsRate{
+		{Month: "2026-01", Income: 300000, Outcome: 106000, SavingsRate: &savingsRate},
+		{Month: "2026-02", Income: 0, Outcome: 5000},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/savings-rate?from=2026-01-01T00:00:00Z&to=2026-02-28T23:59:59Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetSavingsRate(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[
+		{"month":"2026-01","income":300000,"outcome":106000,"savingsRate":64.67},
+		{"month":"2026-02","income":0,"outcome":5000,"savingsRate":null}
+	]`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestReportHandler_GetSavingsRate_InvalidFrom(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/savings-rate?from=2026-01", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(req.Context(), 123))
+	w := httptest.NewRecorder()
+
+	handler.GetSavingsRate(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetSavingsRate")
+}
+
+func TestReportHandler_GetSavingsRate_InvalidDates(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("GetSavingsRate", ctx, mock.Anything, mock.Anything, 123).Return(nil, &domain.InvalidDateError{UnderlyingCause: errors.New("start date must be before end date")})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/savings-rate?from=2026-03-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.GetSavingsRate(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestReportHandler_ExportMonthlyStatement(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetMonthlyStatement", ctx, from, endOfMonth(from), 123).Return(&domain.MonthlyStatement{
+		Income:  300000,
+		Outcome: 110000,
+		Net:     190000,
+		Categories: []domain.CategoryStatementLine{
+			{Label: "Rent", Total: 90000},
+			{Label: "", Total: 20000},
+			{Label: "Café", Total: 500},
+		},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/monthly.pdf?month=2026-03", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.ExportMonthlyStatement(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/pdf", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="statement-2026-03.pdf"`, resp.Header.Get("Content-Disposition"))
+
+	body := w.Body.Bytes()
+	assert.True(t, bytes.HasPrefix(body, []byte("%PDF-")), "expected PDF magic bytes")
+	assert.True(t, bytes.HasSuffix(body, []byte("%%EOF\n")), "expected PDF end marker")
+	content := pdfContent(t, body)
+	for _, want := range []string{"(Monthly statement - March 2026)", "(Net: 1900.00)", "(Rent)", "(Uncategorized)", "(200.00)", "(Caf\xe9)"} {
+		assert.Contains(t, content, want)
+	}
+
+	mockService.AssertExpectations(t)
+}
+
+// pdfStreams matches the streams of a PDF file.
+var pdfStreams = regexp.MustCompile(`(?s)\nstream\n(.*?)\nendstream`)
+
+// pdfContent returns the inflated streams of a PDF file, holding the text
+// drawn on its pages.
+func pdfContent(t *testing.T, body []byte) string {
+	t.Helper()
+
+	var content bytes.Buffer
+	for _, match := range pdfStreams.FindAllSubmatch(body, -1) {
+		r, err := zlib.NewReader(bytes.NewReader(match[1]))
+		if !assert.NoError(t, err) {
+			continue
+		}
+		_, err = io.Copy(&content, r)
+		assert.NoError(t, err)
+	}
+	return content.String()
+}
+
+func TestReportHandler_ExportMonthlyStatement_InvalidMonth(t *testing.T) {
+	mockService := new(mocks.ReportService)
+	handler := NewReportHandler(mockService, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/monthly.pdf?month=2026-13", nil)
+	req = req.WithContext(auth.ContextWithUserIDForTests(context.Background(), 123))
+	w := httptest.NewRecorder()
+
+	handler.ExportMonthlyStatement(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetMonthlyStatement")
+}
+
+func TestFormatCents(t *testing.T) {
+	for cents, want := range map[int]string{0: "0.00", 5: "0.05", 123456: "1234.56", -1205: "-12.05"} {
+		assert.Equal(t, want, formatCents(cents))
+	}
+}
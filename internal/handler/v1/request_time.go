@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// zonelessLayout is RFC 3339 without the zone offset (ex: "2026-01-01T00:00:00").
+const zonelessLayout = "2006-01-02T15:04:05.999999999"
+
+// RequestTime is a timestamp of a request body. Besides RFC 3339 timestamps it
+// accepts zone-less ones, commonly sent by clients working with local times,
+// which are read in the configured timezone by resolve.
+type RequestTime struct {
+	time.Time
+	zoneless bool
+}
+
+func (t *RequestTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if parsed, err := time.Parse(time.RFC3339, s); err == nil {
+		t.Time, t.zoneless = parsed, false
+		return nil
+	}
+
+	parsed, err := time.Parse(zonelessLayout, s)
+	if err != nil {
+		return fmt.Errorf("invalid date %q, use ISO 8601 (ex: 2026-01-01T00:00:00Z)", s)
+	}
+	t.Time, t.zoneless = parsed, true
+	return nil
+}
+
+// resolve returns the timestamp, zone-less values being read as wall clock
+// times of loc and converted to UTC.
+func (t RequestTime) resolve(loc *time.Location) time.Time {
+	if !t.zoneless {
+		return t.Time
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc).UTC()
+}
+
+// resolveOptional resolves an optional timestamp, nil staying nil.
+func resolveOptional(t *RequestTime, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	resolved := t.resolve(loc)
+	return &resolved
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/kerhael/accounting/internal/domain"
+)
+
+// StatementRenderer renders monthly statements as documents of ContentType, so
+// that the handler does not depend on a given PDF library.
+type StatementRenderer interface {
+	ContentType() string
+	Render(w io.Writer, month time.Time, statement *domain.MonthlyStatement) error
+}
+
+// Layout of the PDF statements in points, on A4 pages.
+const (
+	statementMargin      = 50
+	statementTitleSize   = 18
+	statementTextSize    = 11
+	statementTitleHeight = 28
+	statementLineHeight  = 16
+	statementTotalWidth  = 120
+)
+
+// pdfStatementRenderer renders monthly statements as PDF documents with gofpdf.
+type pdfStatementRenderer struct{}
+
+func (pdfStatementRenderer) ContentType() string {
+	return "application/pdf"
+}
+
+func (pdfStatementRenderer) Render(w io.Writer, month time.Time, statement *domain.MonthlyStatement) error {
+	doc := gofpdf.New("P", "pt", "A4", "")
+	doc.SetMargins(statementMargin, statementMargin, statementMargin)
+	doc.SetAutoPageBreak(true, statementMargin)
+	doc.AddPage()
+
+	// The core fonts only cover cp1252, which category labels are translated to
+	translate := doc.UnicodeTranslatorFromDescriptor("")
+	// cell writes text in a cell of the given width (0 to the right margin),
+	// moving to the next line after it when ln is 1
+	cell := func(width float64, text string, ln int, align string) {
+		doc.CellFormat(width, statementLineHeight, text, "", ln, align, false, 0, "")
+	}
+
+	doc.SetFont("Helvetica", "B", statementTitleSize)
+	doc.CellFormat(0, statementTitleHeight, "Monthly statement - "+month.Format("January 2006"), "", 1, "L", false, 0, "")
+	doc.Ln(statementLineHeight)
+
+	doc.SetFont("Helvetica", "", statementTextSize)
+	cell(0, "Income: "+formatCents(statement.Income), 1, "L")
+	cell(0, "Outcome: "+formatCents(statement.Outcome), 1, "L")
+	cell(0, "Net: "+formatCents(statement.Net), 1, "L")
+	doc.Ln(statementLineHeight)
+
+	pageWidth, _ := doc.GetPageSize()
+	labelWidth := pageWidth - 2*statementMargin - statementTotalWidth
+
+	doc.SetFont("Helvetica", "B", statementTextSize)
+	cell(labelWidth, "Category", 0, "L")
+	cell(statementTotalWidth, "Total", 1, "R")
+
+	doc.SetFont("Helvetica", "", statementTextSize)
+	for _, c := range statement.Categories {
+		label := c.Label
+		if label == "" {
+			label = "Uncategorized"
+		}
+		cell(labelWidth, translate(label), 0, "L")
+		cell(statementTotalWidth, formatCents(c.Total), 1, "R")
+	}
+
+	return doc.Output(w)
+}
+
+// formatCents formats an amount in cents with two decimals (ex: -12.05).
+func formatCents(cents int) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
@@ -0,0 +1,6 @@
+package v1
+
+type TimeResponse struct {
+	Now      string `json:"now"`      // Current database time in RFC3339 format (ex: "2026-01-01T10:00:00+01:00")
+	Timezone string `json:"timezone"` // Configured timezone (ex: "Europe/Paris")
+}
@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kerhael/accounting/internal/handler/utils"
+	"github.com/kerhael/accounting/internal/service"
+)
+
+type TimeHandler struct {
+	service *service.TimeService
+}
+
+func NewTimeHandler(service *service.TimeService) *TimeHandler {
+	return &TimeHandler{service: service}
+}
+
+// Server time
+// @Summary      Server time
+// @Description Retrieve the current database time and the configured timezone, to detect client clock skew
+// @Tags         time
+// @Produce      json
+// @Success      200       {object}   TimeResponse
+// @Failure      500       {object}   ErrorResponse  "Internal server error"
+// @Router       /time [get]
+func (h *TimeHandler) GetTime(w http.ResponseWriter, r *http.Request) {
+	now, err := h.service.Now(r.Context())
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, TimeResponse{
+		Now:      now.Format(time.RFC3339),
+		Timezone: h.service.Location().String(),
+	})
+}
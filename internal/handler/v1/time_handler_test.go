@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+type FakeTimeRepo struct {
+	Err error
+}
+
+func (f FakeTimeRepo) Now(ctx context.Context) (time.Time, error) {
+	if f.Err != nil {
+		return time.Time{}, f.Err
+	}
+	return time.Now(), nil
+}
+
+func TestTimeHandler_GetTime_Success(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	srv := service.NewTimeService(FakeTimeRepo{}, location)
+	handler := NewTimeHandler(srv)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/time", nil)
+	w := httptest.NewRecorder()
+
+	before := time.Now().Add(-time.Second)
+	handler.GetTime(w, req)
+	after := time.Now().Add(time.Second)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var data TimeResponse
+	err = json.NewDecoder(w.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Paris", data.Timezone)
+
+	now, err := time.Parse(time.RFC3339, data.Now)
+	assert.NoError(t, err)
+	assert.True(t, now.After(before) && now.Before(after), "expected %s to be close to the current time", data.Now)
+
+	_, offset := now.Zone()
+	_, expectedOffset := time.Now().In(location).Zone()
+	assert.Equal(t, expectedOffset, offset)
+}
+
+func TestTimeHandler_GetTime_RepoError(t *testing.T) {
+	srv := service.NewTimeService(FakeTimeRepo{Err: errors.New("db down")}, time.UTC)
+	handler := NewTimeHandler(srv)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/time", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetTime(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,17 @@
+package v1
+
+import "time"
+
+type TransactionResponse struct {
+	Type       string     `json:"type"`                 // Transaction type ("income" or "outcome")
+	ID         int        `json:"id"`                   // ID of the income or outcome
+	Name       string     `json:"name"`                 // Name of the transaction
+	Amount     int        `json:"amount"`               // Amount in cents (ex: 1999 for 19.99€)
+	CategoryId int        `json:"categoryId,omitempty"` // ID of the associated category (outcomes only)
+	CreatedAt  *time.Time `json:"createdAt"`            // Date of the transaction (ex: "2026-01-01T00:00:00Z")
+}
+
+type PaginatedTransactionsResponse struct {
+	Data       []TransactionResponse `json:"data"`
+	Pagination PaginationResponse    `json:"pagination"`
+}
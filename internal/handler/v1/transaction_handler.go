@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/handler/utils"
+	"github.com/kerhael/accounting/internal/service"
+)
+
+type TransactionHandler struct {
+	service service.TransactionServiceInterface
+}
+
+func NewTransactionHandler(service service.TransactionServiceInterface) *TransactionHandler {
+	return &TransactionHandler{service: service}
+}
+
+// Search transactions
+// @Summary      Search transactions
+// @Description Search incomes and outcomes whose name contains the given term (case-insensitive), most recent first
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Param        q      query    string  true   "Search term"
+// @Param        offset query    int     false  "Items offset (defaults to 0)"
+// @Param        limit  query    int     false  "Items limit (defaults to 20, max 100)"
+// @Param        signed query    bool    false  "Serialize outcome amounts as negative numbers (defaults to false)"
+// @Success      200   {object}  PaginatedTransactionsResponse
+// @Failure      400   {object}  ErrorResponse  "Bad request error"
+// @Failure      401   {object}  ErrorResponse  "Unauthorized error"
+// @Failure      422   {object}  ErrorResponse  "Validation error"
+// @Failure      500   {object}  ErrorResponse  "Internal server error"
+// @Security BearerAuth
+// @Router       /transactions/search [get]
+func (h *TransactionHandler) SearchTransactions(w http.ResponseWriter, r *http.Request) {
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		utils.WriteJSONError(w, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	term := r.URL.Query().Get("q")
+	if term == "" {
+		utils.WriteJSONError(w, http.StatusBadRequest, "'q' is required")
+		return
+	}
+
+	signed, err := parseBoolParam(r.URL.Query().Get("signed"))
+	if err != nil {
+		utils.WriteJSONError(w, http.StatusBadRequest, "invalid 'signed' value, use true or false")
+		return
+	}
+
+	offset := domain.DefaultOffset
+	limit := domain.DefaultLimit
+
+	offsetStr := r.URL.Query().Get("offset")
+	if offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil || parsedOffset < 0 {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		offset = parsedOffset
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			utils.WriteJSONError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		if parsedLimit > domain.MaxLimit {
+			utils.WriteJSONError(w, http.StatusBadRequest, "limit must be less than or equal to 100")
+			return
+		}
+		limit = parsedLimit
+	}
+
+	transactions, total, err := h.service.Search(r.Context(), term, userId, limit, offset)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	transactionsResp := []TransactionResponse{}
+	for _, t := range transactions {
+		amount := t.Amount
+		if t.Type == domain.TransactionTypeOutcome {
+			amount = signedOutcomeAmount(amount, signed)
+		}
+		transactionsResp = append(transactionsResp, TransactionResponse{
+			Type:       t.Type,
+			ID:         t.ID,
+			Name:       t.Name,
+			Amount:     amount,
+			CategoryId: t.CategoryId,
+			CreatedAt:  t.CreatedAt,
+		})
+	}
+
+	utils.WriteJSON(w, http.StatusOK, PaginatedTransactionsResponse{
+		Data: transactionsResp,
+		Pagination: PaginationResponse{
+			Offset: offset,
+			Limit:  limit,
+			Total:  total,
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionHandler_SearchTransactions_Success(t *testing.T) {
+	mockService := new(mocks.TransactionService)
+	handler := NewTransactionHandler(mockService)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	mockService.On("Search", ctx, "amazon", 123, 20, 0).Return([]domain.Transaction{
+		{Type: domain.TransactionTypeOutcome, ID: 7, Name: "Amazon order", Amount: 2599, CategoryId: 2, CreatedAt: &date},
+		{Type: domain.TransactionTypeIncome, ID: 3, Name: "Amazon refund", Amount: 1500, CreatedAt: &date},
+	}, 2, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/search?q=amazon", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.SearchTransactions(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var data PaginatedTransactionsResponse
+	err := json.NewDecoder(resp.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, []TransactionResponse{
+		{Type: "outcome", ID: 7, Name: "Amazon order", Amount: 2599, CategoryId: 2, CreatedAt: &date},
+		{Type: "income", ID: 3, Name: "Amazon refund", Amount: 1500, CreatedAt: &date},
+	}, data.Data)
+	assert.Equal(t, PaginationResponse{Offset: 0, Limit: 20, Total: 2}, data.Pagination)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestTransactionHandler_SearchTransactions_Signed(t *testing.T) {
+	mockService := new(mocks.TransactionService)
+	handler := NewTransactionHandler(mockService)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	mockService.On("Search", ctx, "amazon", 123, 20, 0).Return([]domain.Transaction{
+		{Type: domain.TransactionTypeOutcome, ID: 7, Name: "Amazon order", Amount: 2599, CategoryId: 2},
+		{Type: domain.TransactionTypeIncome, ID: 3, Name: "Amazon refund", Amount: 1500},
+	}, 2, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/search?q=amazon&signed=true", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.SearchTransactions(w, req)
+
+	var data PaginatedTransactionsResponse
+	err := json.NewDecoder(w.Body).Decode(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, -2599, data.Data[0].Amount)
+	assert.Equal(t, 1500, data.Data[1].Amount)
+}
+
+func TestTransactionHandler_SearchTransactions_MissingTerm(t *testing.T) {
+	mockService := new(mocks.TransactionService)
+	handler := NewTransactionHandler(mockService)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/search", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.SearchTransactions(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "Search")
+}
+
+func TestTransactionHandler_SearchTransactions_InvalidLimit(t *testing.T) {
+	mockService := new(mocks.TransactionService)
+	handler := NewTransactionHandler(mockService)
+
+	ctx := auth.ContextWithUserIDForTests(context.Background(), 123)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/search?q=amazon&limit=500", nil)
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.SearchTransactions(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "Search")
+}
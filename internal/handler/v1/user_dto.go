@@ -1,5 +1,7 @@
 package v1
 
+import "time"
+
 type CreateUserRequest struct {
 	FirstName string `json:"firstName"` // User first name
 	LastName  string `json:"lastName"`  // User last name
@@ -8,14 +10,19 @@ type CreateUserRequest struct {
 }
 
 type UserResponse struct {
-	ID        int    `json:"id"`        // User identifier
-	FirstName string `json:"firstName"` // User first name
-	LastName  string `json:"lastName"`  // User last name
-	Email     string `json:"email"`     // User email
+	ID                int       `json:"id"`                          // User identifier
+	FirstName         string    `json:"firstName"`                   // User first name
+	LastName          string    `json:"lastName"`                    // User last name
+	Email             string    `json:"email"`                       // User email
+	CreatedAt         time.Time `json:"createdAt"`                   // Account creation date (ex: "2026-01-01T00:00:00Z")
+	DefaultCategoryId int       `json:"defaultCategoryId,omitempty"` // Category used for outcomes posted without one (omitted when unset)
+	RequireCategory   bool      `json:"requireCategory"`             // Whether outcomes must have a category
 }
 
 type PatchUserByIdRequest struct {
-	FirstName *string `json:"firstName"` // User first name (optional)
-	LastName  *string `json:"lastName"`  // User last name (optional)
-	Password  *string `json:"password"`  // User password (optional)
+	FirstName         *string `json:"firstName"`         // User first name (optional)
+	LastName          *string `json:"lastName"`          // User last name (optional)
+	Password          *string `json:"password"`          // User password (optional)
+	DefaultCategoryId *int    `json:"defaultCategoryId"` // Default category for quick-entry outcomes, 0 clears it (optional)
+	RequireCategory   *bool   `json:"requireCategory"`   // Reject outcomes without a category, otherwise they may be left uncategorized (optional)
 }
@@ -2,7 +2,6 @@ package v1
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -30,6 +29,7 @@ func NewUserHandler(service service.UserServiceInterface) *UserHandler {
 // @Param        user  body      CreateUserRequest  true  "User payload"
 // @Success      201       {object}   UserResponse
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      429       {object}   ErrorResponse  "Too many requests error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Router       /users/ [post]
@@ -41,34 +41,9 @@ func (h *UserHandler) PostUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.FirstName == "" {
-		utils.WriteJSONError(w, http.StatusBadRequest, "firstName is required")
-		return
-	}
-	if req.LastName == "" {
-		utils.WriteJSONError(w, http.StatusBadRequest, "lastName is required")
-		return
-	}
-	if req.Email == "" {
-		utils.WriteJSONError(w, http.StatusBadRequest, "email is required")
-		return
-	}
-	if strings.TrimSpace(req.Password) == "" {
-		utils.WriteJSONError(w, http.StatusBadRequest, "password is required")
-		return
-	}
-	if len(req.Password) < 8 {
-		utils.WriteJSONError(w, http.StatusBadRequest, "password must be at least 8 characters")
-		return
-	}
-
 	user, err := h.service.Create(r.Context(), req.FirstName, req.LastName, req.Email, req.Password)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -85,6 +60,7 @@ func (h *UserHandler) PostUser(w http.ResponseWriter, r *http.Request) {
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
 // @Failure      404       {object}   ErrorResponse  "User not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /users/me [get]
@@ -97,15 +73,7 @@ func (h *UserHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.service.FindById(r.Context(), userID)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		if error, ok := errors.AsType[*domain.EntityNotFoundError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -124,6 +92,7 @@ func (h *UserHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
 // @Failure      404       {object}   ErrorResponse  "Not found error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /users/{id} [patch]
@@ -166,24 +135,12 @@ func (h *UserHandler) PatchUserById(w http.ResponseWriter, r *http.Request) {
 
 	password := ""
 	if req.Password != nil {
-		if len(*req.Password) < 8 {
-			utils.WriteJSONError(w, http.StatusBadRequest, "password must be at least 8 characters")
-			return
-		}
 		password = *req.Password
 	}
 
-	user, err := h.service.PatchById(r.Context(), userId, firstName, lastName, password)
+	user, err := h.service.PatchById(r.Context(), userId, firstName, lastName, password, req.DefaultCategoryId, req.RequireCategory)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		if error, ok := errors.AsType[*domain.EntityNotFoundError](err); ok {
-			utils.WriteJSONError(w, http.StatusNotFound, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -200,6 +157,7 @@ func (h *UserHandler) PatchUserById(w http.ResponseWriter, r *http.Request) {
 // @Success      204       "No Content"
 // @Failure      400       {object}   ErrorResponse  "Bad request error"
 // @Failure      401       {object}   ErrorResponse  "Unauthorized error"
+// @Failure      422       {object}   ErrorResponse  "Validation error"
 // @Failure      500       {object}   ErrorResponse  "Internal server error"
 // @Security BearerAuth
 // @Router       /users/{id} [delete]
@@ -220,11 +178,7 @@ func (h *UserHandler) DeleteUserById(w http.ResponseWriter, r *http.Request) {
 
 	err = h.service.DeleteById(r.Context(), id)
 	if err != nil {
-		if error, ok := errors.AsType[*domain.InvalidEntityError](err); ok {
-			utils.WriteJSONError(w, http.StatusBadRequest, error.Error())
-			return
-		}
-		utils.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -233,9 +187,12 @@ func (h *UserHandler) DeleteUserById(w http.ResponseWriter, r *http.Request) {
 
 func toUserResponse(user *domain.User) UserResponse {
 	return UserResponse{
-		ID:        user.ID,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Email:     user.Email,
+		ID:                user.ID,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		Email:             user.Email,
+		CreatedAt:         user.CreatedAt,
+		DefaultCategoryId: user.DefaultCategoryId,
+		RequireCategory:   user.RequireCategory,
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/kerhael/accounting/internal/auth"
 	"github.com/kerhael/accounting/internal/domain"
@@ -87,13 +88,18 @@ func TestUserHandler_PostUser_MissingFirstName(t *testing.T) {
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := context.Background()
+	mockService.On("Create", ctx, "", "Doe", "john@example.com", "password123").
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("firstName is required")})
+
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/", bytes.NewReader(body))
+	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
 	handler.PostUser(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
-	mockService.AssertNotCalled(t, "Create")
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+	mockService.AssertExpectations(t)
 }
 
 func TestUserHandler_PostUser_MissingLastName(t *testing.T) {
@@ -107,13 +113,18 @@ func TestUserHandler_PostUser_MissingLastName(t *testing.T) {
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := context.Background()
+	mockService.On("Create", ctx, "John", "", "john@example.com", "password123").
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("lastName is required")})
+
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/", bytes.NewReader(body))
+	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
 	handler.PostUser(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
-	mockService.AssertNotCalled(t, "Create")
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+	mockService.AssertExpectations(t)
 }
 
 func TestUserHandler_PostUser_MissingEmail(t *testing.T) {
@@ -127,13 +138,18 @@ func TestUserHandler_PostUser_MissingEmail(t *testing.T) {
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := context.Background()
+	mockService.On("Create", ctx, "John", "Doe", "", "password123").
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("email is required")})
+
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/", bytes.NewReader(body))
+	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
 	handler.PostUser(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
-	mockService.AssertNotCalled(t, "Create")
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+	mockService.AssertExpectations(t)
 }
 
 func TestUserHandler_PostUser_MissingPassword(t *testing.T) {
@@ -147,13 +163,18 @@ func TestUserHandler_PostUser_MissingPassword(t *testing.T) {
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := context.Background()
+	mockService.On("Create", ctx, "John", "Doe", "john@example.com", "").
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("password is required")})
+
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/", bytes.NewReader(body))
+	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
 	handler.PostUser(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
-	mockService.AssertNotCalled(t, "Create")
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+	mockService.AssertExpectations(t)
 }
 
 func TestUserHandler_PostUser_ShortPassword(t *testing.T) {
@@ -168,13 +189,18 @@ func TestUserHandler_PostUser_ShortPassword(t *testing.T) {
 	}
 	body, _ := json.Marshal(input)
 
+	ctx := context.Background()
+	mockService.On("Create", ctx, "John", "Doe", "john@example.com", "short").
+		Return(nil, &domain.InvalidEntityError{UnderlyingCause: errors.New("password must be at least 8 characters")})
+
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/", bytes.NewReader(body))
+	req = req.WithContext(ctx)
 	w := httptest.NewRecorder()
 
 	handler.PostUser(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
-	mockService.AssertNotCalled(t, "Create")
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+	mockService.AssertExpectations(t)
 }
 
 func TestUserHandler_PostUser_InvalidEntityError(t *testing.T) {
@@ -200,7 +226,7 @@ func TestUserHandler_PostUser_InvalidEntityError(t *testing.T) {
 
 	handler.PostUser(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
 	mockService.AssertExpectations(t)
 }
 
@@ -319,6 +345,7 @@ func TestUserHandler_GetMe_Success(t *testing.T) {
 		FirstName: "John",
 		LastName:  "Doe",
 		Email:     "john@example.com",
+		CreatedAt: time.Date(2026, 2, 18, 11, 2, 0, 0, time.UTC),
 	}
 
 	mockService.On("FindById", mock.Anything, 123).Return(user, nil)
@@ -339,6 +366,8 @@ func TestUserHandler_GetMe_Success(t *testing.T) {
 	assert.Equal(t, user.FirstName, response.FirstName)
 	assert.Equal(t, user.LastName, response.LastName)
 	assert.Equal(t, user.Email, response.Email)
+	assert.Equal(t, user.CreatedAt, response.CreatedAt)
+	assert.Contains(t, w.Body.String(), `"createdAt":"2026-02-18T11:02:00Z"`)
 
 	mockService.AssertExpectations(t)
 }
@@ -400,7 +429,7 @@ func TestUserHandler_GetMe_InvalidEntityError(t *testing.T) {
 	w := httptest.NewRecorder()
 	handler.GetMe(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
 
 	var response ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &response)
@@ -455,7 +484,7 @@ func TestUserHandler_PatchUserById_Success_AllFields(t *testing.T) {
 		LastName:  "Doe",
 		Email:     "john@example.com",
 	}
-	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "newpassword123").Return(expectedUser, nil)
+	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "newpassword123", (*int)(nil), (*bool)(nil)).Return(expectedUser, nil)
 
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/"+strconv.Itoa(userID), bytes.NewBuffer(reqBodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -498,7 +527,7 @@ func TestUserHandler_PatchUserById_Success_FirstNameOnly(t *testing.T) {
 		LastName:  "Doe",
 		Email:     "john@example.com",
 	}
-	mockService.On("PatchById", mock.Anything, userID, "Jane", "", "").Return(expectedUser, nil)
+	mockService.On("PatchById", mock.Anything, userID, "Jane", "", "", (*int)(nil), (*bool)(nil)).Return(expectedUser, nil)
 
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/"+strconv.Itoa(userID), bytes.NewBuffer(reqBodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -541,7 +570,7 @@ func TestUserHandler_PatchUserById_Success_LastNameOnly(t *testing.T) {
 		LastName:  "Doe",
 		Email:     "john@example.com",
 	}
-	mockService.On("PatchById", mock.Anything, userID, "", "Doe", "").Return(expectedUser, nil)
+	mockService.On("PatchById", mock.Anything, userID, "", "Doe", "", (*int)(nil), (*bool)(nil)).Return(expectedUser, nil)
 
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/"+strconv.Itoa(userID), bytes.NewBuffer(reqBodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -584,7 +613,7 @@ func TestUserHandler_PatchUserById_Success_PasswordOnly(t *testing.T) {
 		LastName:  "Doe",
 		Email:     "john@example.com",
 	}
-	mockService.On("PatchById", mock.Anything, userID, "", "", "newpassword123").Return(expectedUser, nil)
+	mockService.On("PatchById", mock.Anything, userID, "", "", "newpassword123", (*int)(nil), (*bool)(nil)).Return(expectedUser, nil)
 
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/"+strconv.Itoa(userID), bytes.NewBuffer(reqBodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -720,6 +749,11 @@ func TestUserHandler_PatchUserById_ShortPassword(t *testing.T) {
 	}
 	reqBodyBytes, _ := json.Marshal(reqBody)
 
+	invalidErr := &domain.InvalidEntityError{
+		UnderlyingCause: errors.New("password must be at least 8 characters"),
+	}
+	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "short", (*int)(nil), (*bool)(nil)).Return((*domain.User)(nil), invalidErr)
+
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/"+strconv.Itoa(userID), bytes.NewBuffer(reqBodyBytes))
 	req.Header.Set("Content-Type", "application/json")
 	ctx := auth.ContextWithUserIDForTests(req.Context(), userID)
@@ -729,14 +763,14 @@ func TestUserHandler_PatchUserById_ShortPassword(t *testing.T) {
 	w := httptest.NewRecorder()
 	handler.PatchUserById(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
 
 	var response ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err)
-	assert.Equal(t, "password must be at least 8 characters", response.Message)
+	assert.Equal(t, "invalid entity data: password must be at least 8 characters", response.Message)
 
-	mockService.AssertNotCalled(t, "PatchById")
+	mockService.AssertExpectations(t)
 }
 
 func TestUserHandler_PatchUserById_InvalidEntityError(t *testing.T) {
@@ -757,7 +791,7 @@ func TestUserHandler_PatchUserById_InvalidEntityError(t *testing.T) {
 	invalidErr := &domain.InvalidEntityError{
 		UnderlyingCause: errors.New("invalid id"),
 	}
-	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "newpassword123").Return((*domain.User)(nil), invalidErr)
+	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "newpassword123", (*int)(nil), (*bool)(nil)).Return((*domain.User)(nil), invalidErr)
 
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/"+strconv.Itoa(userID), bytes.NewBuffer(reqBodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -768,7 +802,7 @@ func TestUserHandler_PatchUserById_InvalidEntityError(t *testing.T) {
 	w := httptest.NewRecorder()
 	handler.PatchUserById(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
 
 	var response ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &response)
@@ -796,7 +830,7 @@ func TestUserHandler_PatchUserById_EntityNotFoundError(t *testing.T) {
 	notFoundErr := &domain.EntityNotFoundError{
 		UnderlyingCause: errors.New("user not found"),
 	}
-	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "newpassword123").Return((*domain.User)(nil), notFoundErr)
+	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "newpassword123", (*int)(nil), (*bool)(nil)).Return((*domain.User)(nil), notFoundErr)
 
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/"+strconv.Itoa(userID), bytes.NewBuffer(reqBodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -833,7 +867,7 @@ func TestUserHandler_PatchUserById_ServiceError(t *testing.T) {
 	reqBodyBytes, _ := json.Marshal(reqBody)
 
 	serviceErr := errors.New("database error")
-	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "newpassword123").Return((*domain.User)(nil), serviceErr)
+	mockService.On("PatchById", mock.Anything, userID, "Jane", "Doe", "newpassword123", (*int)(nil), (*bool)(nil)).Return((*domain.User)(nil), serviceErr)
 
 	req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/"+strconv.Itoa(userID), bytes.NewBuffer(reqBodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -932,7 +966,7 @@ func TestUserHandler_DeleteUserById_InvalidEntityError(t *testing.T) {
 	w := httptest.NewRecorder()
 	handler.DeleteUserById(w, req)
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
 
 	var response ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &response)
@@ -2,37 +2,92 @@ package repository
 
 import (
 	"context"
+	"strconv"
+	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kerhael/accounting/internal/domain"
 )
 
 type CategoryRepository interface {
 	Create(ctx context.Context, c *domain.Category) error
+	CreateAllIfNone(ctx context.Context, categories []domain.Category, userId int) (bool, error)
 	FindAll(ctx context.Context, userId int) ([]domain.Category, error)
+	FindAllBySpend(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.Category, error)
 	FindById(ctx context.Context, id int, userId int) (*domain.Category, error)
+	FindDefault(ctx context.Context, userId int) (*domain.Category, error)
+	FindAllowedIds(ctx context.Context, userId int) ([]int, error)
+	FindUsage(ctx context.Context, userId int) ([]domain.CategoryUsage, error)
+	IsCategoryRequired(ctx context.Context, userId int) (bool, error)
+	Update(ctx context.Context, c *domain.Category) error
 	DeleteById(ctx context.Context, id int, userId int) error
+	ExistsByUser(ctx context.Context, userId int) (bool, error)
 }
 
 type PostgresCategoryRepository struct {
-	db *pgxpool.Pool
+	db DB
 }
 
-func NewCategoryRepository(db *pgxpool.Pool) *PostgresCategoryRepository {
+func NewCategoryRepository(db DB) *PostgresCategoryRepository {
 	return &PostgresCategoryRepository{db: db}
 }
 
 func (r *PostgresCategoryRepository) Create(ctx context.Context, c *domain.Category) error {
 	query := `
-		INSERT INTO categories (label, user_id)
-		VALUES ($1, $2)
+		INSERT INTO categories (label, color, user_id)
+		VALUES ($1, NULLIF($2, ''), $3)
 		RETURNING id
 	`
-	return r.db.QueryRow(ctx, query, c.Label, c.UserId).Scan(&c.ID)
+	return r.db.QueryRow(ctx, query, c.Label, c.Color, c.UserId).Scan(&c.ID)
+}
+
+// defaultsLockClass namespaces the advisory locks taken by CreateAllIfNone.
+const defaultsLockClass = 1
+
+// CreateAllIfNone creates the categories in a single transaction, setting their
+// IDs, unless the user already has categories, in which case it returns false.
+// Concurrent calls for the same user are serialized by an advisory lock, so only
+// one of them creates the categories.
+func (r *PostgresCategoryRepository) CreateAllIfNone(ctx context.Context, categories []domain.Category, userId int) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	// A no-op once committed
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1, $2)`, defaultsLockClass, userId); err != nil {
+		return false, err
+	}
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM categories WHERE user_id = $1)`, userId).Scan(&exists); err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	query := `
+		INSERT INTO categories (label, color, user_id)
+		VALUES ($1, NULLIF($2, ''), $3)
+		RETURNING id
+	`
+	for i := range categories {
+		c := &categories[i]
+		if err := tx.QueryRow(ctx, query, c.Label, c.Color, c.UserId).Scan(&c.ID); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
 func (r *PostgresCategoryRepository) FindAll(ctx context.Context, userId int) ([]domain.Category, error) {
-	query := `SELECT id, label, user_id FROM categories WHERE user_id = $1 ORDER BY label`
+	query := `SELECT id, label, COALESCE(color, ''), user_id FROM categories WHERE user_id = $1 ORDER BY label`
 
 	rows, err := r.db.Query(ctx, query, userId)
 	if err != nil {
@@ -43,7 +98,57 @@ func (r *PostgresCategoryRepository) FindAll(ctx context.Context, userId int) ([
 	var categories []domain.Category
 	for rows.Next() {
 		var c domain.Category
-		if err := rows.Scan(&c.ID, &c.Label, &c.UserId); err != nil {
+		if err := rows.Scan(&c.ID, &c.Label, &c.Color, &c.UserId); err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// FindAllBySpend returns the user's categories ordered by total outcome amount descending,
+// then by label. Date filters only apply to the summed outcomes, so unused categories are kept.
+func (r *PostgresCategoryRepository) FindAllBySpend(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.Category, error) {
+	query := `
+		SELECT c.id, c.label, COALESCE(c.color, ''), c.user_id
+		FROM categories c
+		LEFT JOIN outcomes o ON o.category_id = c.id AND o.user_id = c.user_id
+	`
+	args := []any{}
+	argCount := 1
+
+	if from != nil {
+		query += " AND o.created_at >= $" + strconv.Itoa(argCount)
+		args = append(args, *from)
+		argCount++
+	}
+
+	if to != nil {
+		query += " AND o.created_at <= $" + strconv.Itoa(argCount)
+		args = append(args, *to)
+		argCount++
+	}
+
+	query += " WHERE c.user_id = $" + strconv.Itoa(argCount)
+	args = append(args, userId)
+
+	query += " GROUP BY c.id, c.label, c.color, c.user_id ORDER BY COALESCE(SUM(o.amount), 0) DESC, c.label"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []domain.Category
+	for rows.Next() {
+		var c domain.Category
+		if err := rows.Scan(&c.ID, &c.Label, &c.Color, &c.UserId); err != nil {
 			return nil, err
 		}
 		categories = append(categories, c)
@@ -60,11 +165,29 @@ func (r *PostgresCategoryRepository) FindById(ctx context.Context, id int, userI
 	var c domain.Category
 
 	query := `
-		SELECT id, label, user_id FROM categories
+		SELECT id, label, COALESCE(color, ''), user_id FROM categories
 		WHERE id = $1 and user_id = $2
 	`
 
-	err := r.db.QueryRow(ctx, query, id, userId).Scan(&c.ID, &c.Label, &c.UserId)
+	err := r.db.QueryRow(ctx, query, id, userId).Scan(&c.ID, &c.Label, &c.Color, &c.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// FindDefault returns the user's default category, or pgx.ErrNoRows when none is set.
+func (r *PostgresCategoryRepository) FindDefault(ctx context.Context, userId int) (*domain.Category, error) {
+	var c domain.Category
+
+	query := `
+		SELECT c.id, c.label, COALESCE(c.color, ''), c.user_id FROM categories c
+		JOIN users u ON u.default_category_id = c.id
+		WHERE u.id = $1 AND c.user_id = $1 AND u.deleted_at IS NULL
+	`
+
+	err := r.db.QueryRow(ctx, query, userId).Scan(&c.ID, &c.Label, &c.Color, &c.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +195,91 @@ func (r *PostgresCategoryRepository) FindById(ctx context.Context, id int, userI
 	return &c, nil
 }
 
+// FindAllowedIds returns the IDs of the only categories the user may log outcomes
+// in, none meaning the user is unrestricted.
+func (r *PostgresCategoryRepository) FindAllowedIds(ctx context.Context, userId int) ([]int, error) {
+	query := `SELECT category_id FROM user_allowed_categories WHERE user_id = $1 ORDER BY category_id`
+
+	rows, err := r.db.Query(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// FindUsage returns the user's categories with their last use and lifetime outcome
+// count, never used categories first, then the least recently used.
+func (r *PostgresCategoryRepository) FindUsage(ctx context.Context, userId int) ([]domain.CategoryUsage, error) {
+	query := `
+		SELECT c.id, c.label, COALESCE(c.color, ''), c.user_id, MAX(o.created_at), COUNT(o.id)
+		FROM categories c
+		LEFT JOIN outcomes o ON o.category_id = c.id AND o.user_id = c.user_id
+		WHERE c.user_id = $1
+		GROUP BY c.id, c.label, c.color, c.user_id
+		ORDER BY MAX(o.created_at) NULLS FIRST, c.label
+	`
+
+	rows, err := r.db.Query(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var usages []domain.CategoryUsage
+	for rows.Next() {
+		var u domain.CategoryUsage
+		if err := rows.Scan(&u.ID, &u.Label, &u.Color, &u.UserId, &u.LastUsedAt, &u.OutcomeCount); err != nil {
+			return nil, err
+		}
+		usages = append(usages, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return usages, nil
+}
+
+// IsCategoryRequired reports whether the user's outcomes must have a category.
+func (r *PostgresCategoryRepository) IsCategoryRequired(ctx context.Context, userId int) (bool, error) {
+	query := `SELECT require_category FROM users WHERE id = $1 AND deleted_at IS NULL`
+
+	var required bool
+	err := r.db.QueryRow(ctx, query, userId).Scan(&required)
+	if err != nil {
+		return false, err
+	}
+
+	return required, nil
+}
+
+func (r *PostgresCategoryRepository) Update(ctx context.Context, c *domain.Category) error {
+	query := `
+		UPDATE categories
+		SET label = $1, color = NULLIF($2, '')
+		WHERE id = $3 and user_id = $4
+	`
+
+	_, err := r.db.Exec(ctx, query, c.Label, c.Color, c.ID, c.UserId)
+	return err
+}
+
 func (r *PostgresCategoryRepository) DeleteById(ctx context.Context, id int, userId int) error {
 	query := `
 		DELETE FROM categories
@@ -81,3 +289,15 @@ func (r *PostgresCategoryRepository) DeleteById(ctx context.Context, id int, use
 	_, err := r.db.Exec(ctx, query, id, userId)
 	return err
 }
+
+func (r *PostgresCategoryRepository) ExistsByUser(ctx context.Context, userId int) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM categories WHERE user_id = $1)`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, userId).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
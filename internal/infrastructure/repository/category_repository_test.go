@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgresCategoryRepository_FindAllBySpend(t *testing.T) {
+
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewCategoryRepository(mock)
+
+	rows := pgxmock.NewRows([]string{"id", "label", "color", "user_id"}).
+		AddRow(3, "Rent", "", 123).
+		AddRow(1, "Food", "#FF0000", 123).
+		AddRow(2, "Books", "", 123)
+
+	mock.ExpectQuery(`SELECT (.+) FROM categories c LEFT JOIN outcomes o (.+) WHERE c.user_id = \$1 GROUP BY (.+) ORDER BY COALESCE\(SUM\(o.amount\), 0\) DESC, c.label`).
+		WithArgs(123).
+		WillReturnRows(rows)
+
+	categories, err := repo.FindAllBySpend(context.Background(), nil, nil, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Category{
+		{ID: 3, Label: "Rent", UserId: 123},
+		{ID: 1, Label: "Food", Color: "#FF0000", UserId: 123},
+		{ID: 2, Label: "Books", UserId: 123},
+	}, categories)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresCategoryRepository_FindUsage(t *testing.T) {
+
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewCategoryRepository(mock)
+
+	lastUsed := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	rows := pgxmock.NewRows([]string{"id", "label", "color", "user_id", "max", "count"}).
+		AddRow(2, "Books", "", 123, nil, 0).
+		AddRow(1, "Food", "#FF0000", 123, &lastUsed, 42)
+
+	mock.ExpectQuery(`SELECT (.+), MAX\(o.created_at\), COUNT\(o.id\) FROM categories c LEFT JOIN outcomes o (.+) WHERE c.user_id = \$1 GROUP BY (.+) ORDER BY MAX\(o.created_at\) NULLS FIRST, c.label`).
+		WithArgs(123).
+		WillReturnRows(rows)
+
+	usages, err := repo.FindUsage(context.Background(), 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CategoryUsage{
+		{Category: domain.Category{ID: 2, Label: "Books", UserId: 123}},
+		{Category: domain.Category{ID: 1, Label: "Food", Color: "#FF0000", UserId: 123}, LastUsedAt: &lastUsed, OutcomeCount: 42},
+	}, usages)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresCategoryRepository_FindAllBySpend_WithDates(t *testing.T) {
+
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewCategoryRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"id", "label", "color", "user_id"}).
+		AddRow(1, "Food", "", 123)
+
+	mock.ExpectQuery(`LEFT JOIN outcomes o ON o.category_id = c.id AND o.user_id = c.user_id AND o.created_at >= \$1 AND o.created_at <= \$2 WHERE c.user_id = \$3`).
+		WithArgs(from, to, 123).
+		WillReturnRows(rows)
+
+	categories, err := repo.FindAllBySpend(context.Background(), &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Len(t, categories, 1)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresCategoryRepository_CreateAllIfNone(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewCategoryRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock\(\$1, \$2\)`).
+		WithArgs(defaultsLockClass, 123).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM categories WHERE user_id = \$1\)`).
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("INSERT INTO categories").
+		WithArgs("Groceries", "", 123).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery("INSERT INTO categories").
+		WithArgs("Housing", "", 123).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+
+	categories := []domain.Category{{Label: "Groceries", UserId: 123}, {Label: "Housing", UserId: 123}}
+	created, err := repo.CreateAllIfNone(context.Background(), categories, 123)
+
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, []int{1, 2}, []int{categories[0].ID, categories[1].ID})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresCategoryRepository_CreateAllIfNone_UserHasCategories(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewCategoryRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs(defaultsLockClass, 123).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	created, err := repo.CreateAllIfNone(context.Background(), []domain.Category{{Label: "Groceries", UserId: 123}}, 123)
+
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresCategoryRepository_CreateAllIfNone_RollsBackOnFailure(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewCategoryRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs(defaultsLockClass, 123).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("INSERT INTO categories").
+		WithArgs("Groceries", "", 123).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery("INSERT INTO categories").
+		WithArgs("Housing", "", 123).
+		WillReturnError(errors.New("connection reset"))
+	// The first starter is not kept
+	mock.ExpectRollback()
+
+	created, err := repo.CreateAllIfNone(context.Background(), []domain.Category{{Label: "Groceries", UserId: 123}, {Label: "Housing", UserId: 123}}, 123)
+
+	assert.EqualError(t, err, "connection reset")
+	assert.False(t, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -14,7 +14,13 @@ type IncomeRepository interface {
 	CountAll(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error)
 	FindById(ctx context.Context, id int, userId int) (*domain.Income, error)
 	Update(ctx context.Context, o *domain.Income) error
-	DeleteById(ctx context.Context, id int, userId int) error
+	DeleteById(ctx context.Context, id int, userId int) (int64, error)
+	ExistsByUser(ctx context.Context, userId int) (bool, error)
+	GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error)
+	FindLargest(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.Income, error)
+	GetStats(ctx context.Context, from *time.Time, to *time.Time, userId int) (*domain.AmountStats, error)
+	GetTotalSum(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error)
+	GetMonthlyTotalSeries(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyTotalSeries, error)
 }
 
 type PostgresIncomeRepository struct {
@@ -134,12 +140,205 @@ func (r *PostgresIncomeRepository) Update(ctx context.Context, i *domain.Income)
 	return err
 }
 
-func (r *PostgresIncomeRepository) DeleteById(ctx context.Context, id int, userId int) error {
+func (r *PostgresIncomeRepository) DeleteById(ctx context.Context, id int, userId int) (int64, error) {
 	query := `
 		DELETE FROM incomes
 		WHERE id = $1 AND user_id = $2
 	`
 
-	_, err := r.db.Exec(ctx, query, id, userId)
-	return err
+	tag, err := r.db.Exec(ctx, query, id, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *PostgresIncomeRepository) ExistsByUser(ctx context.Context, userId int) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM incomes WHERE user_id = $1)`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, userId).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *PostgresIncomeRepository) GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error) {
+	query := `SELECT MIN(created_at), MAX(created_at) FROM incomes WHERE user_id = $1`
+
+	var minDate, maxDate *time.Time
+	err := r.db.QueryRow(ctx, query, userId).Scan(&minDate, &maxDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// No incomes yet
+	if minDate == nil || maxDate == nil {
+		return nil, nil
+	}
+
+	return &domain.DateBounds{Min: *minDate, Max: *maxDate}, nil
+}
+
+func (r *PostgresIncomeRepository) FindLargest(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.Income, error) {
+	query := `SELECT id, name, amount, created_at, user_id FROM incomes WHERE user_id = $1`
+	args := []any{userId}
+	argCount := 1
+
+	if from != nil {
+		argCount++
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
+	}
+
+	query += ` ORDER BY amount DESC, created_at DESC, id DESC`
+	argCount++
+	query += ` LIMIT $` + strconv.Itoa(argCount)
+	args = append(args, limit)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var incomes []domain.Income
+	for rows.Next() {
+		var i domain.Income
+		if err := rows.Scan(&i.ID, &i.Name, &i.Amount, &i.CreatedAt, &i.UserId); err != nil {
+			return nil, err
+		}
+		incomes = append(incomes, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return incomes, nil
+}
+
+func (r *PostgresIncomeRepository) GetStats(ctx context.Context, from *time.Time, to *time.Time, userId int) (*domain.AmountStats, error) {
+	query := `
+		SELECT
+			COUNT(*) AS count,
+			COALESCE(MIN(amount), 0) AS min,
+			COALESCE(MAX(amount), 0) AS max,
+			COALESCE(ROUND(AVG(amount)), 0)::int AS average,
+			COALESCE(ROUND(percentile_cont(0.5) WITHIN GROUP (ORDER BY amount)), 0)::int AS median
+		FROM incomes
+		WHERE user_id = $1`
+	args := []any{userId}
+	argCount := 1
+
+	if from != nil {
+		argCount++
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
+	}
+
+	var s domain.AmountStats
+	err := r.db.QueryRow(ctx, query, args...).Scan(&s.Count, &s.Min, &s.Max, &s.Average, &s.Median)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *PostgresIncomeRepository) GetTotalSum(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error) {
+	query := `SELECT COALESCE(SUM(amount), 0) as total FROM incomes WHERE user_id = $1`
+	args := []any{userId}
+	argCount := 1
+
+	if from != nil {
+		argCount++
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
+	}
+
+	var total int
+	err := r.db.QueryRow(ctx, query, args...).Scan(&total)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// GetMonthlyTotalSeries returns the incomes total of each month between from and
+// to, months without incomes included with a zero total.
+func (r *PostgresIncomeRepository) GetMonthlyTotalSeries(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyTotalSeries, error) {
+	query := `
+		WITH months AS (
+			SELECT generate_series(
+				date_trunc('month', $1::date),
+				date_trunc('month', $2::date),
+				interval '1 month'
+			) AS month
+		),
+		agg_incomes AS (
+			SELECT
+				date_trunc('month', i.created_at) AS month,
+				SUM(i.amount) AS total
+			FROM incomes i
+			WHERE i.user_id = $3
+			GROUP BY date_trunc('month', i.created_at)
+		)
+		SELECT
+			to_char(m.month, 'YYYY-MM') AS month,
+			COALESCE(a.total, 0) AS total
+		FROM months m
+		LEFT JOIN agg_incomes a
+			ON a.month = m.month
+		ORDER BY m.month
+	`
+
+	rows, err := r.db.Query(ctx, query, *from, *to, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var series []domain.MonthlyTotalSeries
+	for rows.Next() {
+		var s domain.MonthlyTotalSeries
+		if err := rows.Scan(&s.Month, &s.Total); err != nil {
+			return nil, err
+		}
+		series = append(series, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return series, nil
 }
@@ -145,8 +145,209 @@ func TestPostgresIncomeRepository_DeleteById(t *testing.T) {
 		WithArgs(1, 123).
 		WillReturnResult(pgxmock.NewResult("DELETE", 1))
 
-	err := repo.DeleteById(context.Background(), 1, 123)
+	deleted, err := repo.DeleteById(context.Background(), 1, 123)
 
 	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_DeleteById_NotFound(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	mock.ExpectExec("DELETE FROM incomes").
+		WithArgs(99, 123).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	deleted, err := repo.DeleteById(context.Background(), 99, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_ExistsByUser(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	mock.ExpectQuery("SELECT EXISTS (.+) FROM incomes").
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := repo.ExistsByUser(context.Background(), 123)
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_FindLargest(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+	now := time.Now()
+	rows := pgxmock.NewRows(
+		[]string{"id", "name", "amount", "created_at", "user_id"},
+	).
+		AddRow(3, "Salary", 250000, &now, 123).
+		AddRow(1, "Bonus", 50000, &now, 123)
+
+	mock.ExpectQuery("SELECT (.+) FROM incomes (.+) ORDER BY amount DESC").
+		WithArgs(123, from, to, 2).
+		WillReturnRows(rows)
+
+	incomes, err := repo.FindLargest(context.Background(), &from, &to, 123, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, incomes, 2)
+	assert.Equal(t, 250000, incomes[0].Amount)
+	assert.Equal(t, 50000, incomes[1].Amount)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_GetStats(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	// Dataset: 1000, 2000, 4000, 9000 -> median 3000, max 9000, avg 4000
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+	rows := pgxmock.NewRows([]string{"count", "min", "max", "average", "median"}).
+		AddRow(4, 1000, 9000, 4000, 3000)
+
+	mock.ExpectQuery("SELECT (.+)percentile_cont(.+) FROM incomes").
+		WithArgs(123, from, to).
+		WillReturnRows(rows)
+
+	stats, err := repo.GetStats(context.Background(), &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, stats.Count)
+	assert.Equal(t, 1000, stats.Min)
+	assert.Equal(t, 9000, stats.Max)
+	assert.Equal(t, 4000, stats.Average)
+	assert.Equal(t, 3000, stats.Median)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_GetStats_Empty(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	rows := pgxmock.NewRows([]string{"count", "min", "max", "average", "median"}).
+		AddRow(0, 0, 0, 0, 0)
+
+	mock.ExpectQuery("SELECT (.+) FROM incomes").
+		WithArgs(123).
+		WillReturnRows(rows)
+
+	stats, err := repo.GetStats(context.Background(), nil, nil, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, stats.Count)
+	assert.Equal(t, 0, stats.Median)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_GetDateBounds(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	minDate := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2026, 9, 30, 18, 30, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT MIN\\(created_at\\), MAX\\(created_at\\) FROM incomes").
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"min", "max"}).AddRow(&minDate, &maxDate))
+
+	bounds, err := repo.GetDateBounds(context.Background(), 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.DateBounds{Min: minDate, Max: maxDate}, bounds)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_GetDateBounds_Empty(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	mock.ExpectQuery("SELECT MIN\\(created_at\\), MAX\\(created_at\\) FROM incomes").
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"min", "max"}).AddRow(nil, nil))
+
+	bounds, err := repo.GetDateBounds(context.Background(), 123)
+
+	assert.NoError(t, err)
+	assert.Nil(t, bounds)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_GetTotalSum(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(amount\), 0\) as total FROM incomes`).
+		WithArgs(123, from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"total"}).AddRow(300000))
+
+	total, err := repo.GetTotalSum(context.Background(), &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 300000, total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIncomeRepository_GetMonthlyTotalSeries(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewIncomeRepository(mock)
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"month", "total"}).
+		AddRow("2023-01", 250000).
+		AddRow("2023-02", 0).
+		AddRow("2023-03", 260000)
+
+	mock.ExpectQuery("WITH months AS").
+		WithArgs(from, to, 123).
+		WillReturnRows(rows)
+
+	series, err := repo.GetMonthlyTotalSeries(context.Background(), &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.MonthlyTotalSeries{
+		{Month: "2023-01", Total: 250000},
+		{Month: "2023-02", Total: 0},
+		{Month: "2023-03", Total: 260000},
+	}, series)
+
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -16,6 +17,16 @@ func (m *CategoryRepository) Create(ctx context.Context, c *domain.Category) err
 	return args.Error(0)
 }
 
+func (m *CategoryRepository) CreateAllIfNone(ctx context.Context, categories []domain.Category, userId int) (bool, error) {
+	args := m.Called(ctx, categories, userId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *CategoryRepository) Update(ctx context.Context, c *domain.Category) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
 func (m *CategoryRepository) FindAll(ctx context.Context, userId int) ([]domain.Category, error) {
 	args := m.Called(ctx, userId)
 
@@ -27,6 +38,33 @@ func (m *CategoryRepository) FindAll(ctx context.Context, userId int) ([]domain.
 	return categories, args.Error(1)
 }
 
+func (m *CategoryRepository) FindAllBySpend(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.Category, error) {
+	args := m.Called(ctx, from, to, userId)
+
+	var categories []domain.Category
+	if args.Get(0) != nil {
+		categories = args.Get(0).([]domain.Category)
+	}
+
+	return categories, args.Error(1)
+}
+
+func (m *CategoryRepository) FindUsage(ctx context.Context, userId int) ([]domain.CategoryUsage, error) {
+	args := m.Called(ctx, userId)
+
+	var usages []domain.CategoryUsage
+	if args.Get(0) != nil {
+		usages = args.Get(0).([]domain.CategoryUsage)
+	}
+
+	return usages, args.Error(1)
+}
+
+func (m *CategoryRepository) IsCategoryRequired(ctx context.Context, userId int) (bool, error) {
+	args := m.Called(ctx, userId)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *CategoryRepository) FindById(ctx context.Context, id int, userId int) (*domain.Category, error) {
 	args := m.Called(ctx, id, userId)
 
@@ -38,7 +76,34 @@ func (m *CategoryRepository) FindById(ctx context.Context, id int, userId int) (
 	return category, args.Error(1)
 }
 
+func (m *CategoryRepository) FindDefault(ctx context.Context, userId int) (*domain.Category, error) {
+	args := m.Called(ctx, userId)
+
+	var category *domain.Category
+	if args.Get(0) != nil {
+		category = args.Get(0).(*domain.Category)
+	}
+
+	return category, args.Error(1)
+}
+
+func (m *CategoryRepository) FindAllowedIds(ctx context.Context, userId int) ([]int, error) {
+	args := m.Called(ctx, userId)
+
+	var ids []int
+	if args.Get(0) != nil {
+		ids = args.Get(0).([]int)
+	}
+
+	return ids, args.Error(1)
+}
+
 func (m *CategoryRepository) DeleteById(ctx context.Context, id int, userId int) error {
 	args := m.Called(ctx, id, userId)
 	return args.Error(0)
 }
+
+func (m *CategoryRepository) ExistsByUser(ctx context.Context, userId int) (bool, error) {
+	args := m.Called(ctx, userId)
+	return args.Bool(0), args.Error(1)
+}
@@ -55,7 +55,58 @@ func (m *IncomeRepository) Update(ctx context.Context, i *domain.Income) error {
 	return args.Error(0)
 }
 
-func (m *IncomeRepository) DeleteById(ctx context.Context, id int, userId int) error {
+func (m *IncomeRepository) DeleteById(ctx context.Context, id int, userId int) (int64, error) {
 	args := m.Called(ctx, id, userId)
-	return args.Error(0)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *IncomeRepository) ExistsByUser(ctx context.Context, userId int) (bool, error) {
+	args := m.Called(ctx, userId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *IncomeRepository) FindLargest(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.Income, error) {
+	args := m.Called(ctx, from, to, userId, limit)
+
+	var incomes []domain.Income
+	if args.Get(0) != nil {
+		incomes = args.Get(0).([]domain.Income)
+	}
+
+	return incomes, args.Error(1)
+}
+
+func (m *IncomeRepository) GetStats(ctx context.Context, from *time.Time, to *time.Time, userId int) (*domain.AmountStats, error) {
+	args := m.Called(ctx, from, to, userId)
+
+	var stats *domain.AmountStats
+	if args.Get(0) != nil {
+		stats = args.Get(0).(*domain.AmountStats)
+	}
+
+	return stats, args.Error(1)
+}
+
+func (m *IncomeRepository) GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error) {
+	args := m.Called(ctx, userId)
+	if bounds, ok := args.Get(0).(*domain.DateBounds); ok {
+		return bounds, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *IncomeRepository) GetTotalSum(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error) {
+	args := m.Called(ctx, from, to, userId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *IncomeRepository) GetMonthlyTotalSeries(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyTotalSeries, error) {
+	args := m.Called(ctx, from, to, userId)
+
+	var series []domain.MonthlyTotalSeries
+	if args.Get(0) != nil {
+		series = args.Get(0).([]domain.MonthlyTotalSeries)
+	}
+
+	return series, args.Error(1)
 }
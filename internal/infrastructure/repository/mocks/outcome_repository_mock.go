@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/infrastructure/repository"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -28,6 +29,17 @@ func (m *OutcomeRepository) FindAll(ctx context.Context, from *time.Time, to *ti
 	return outcomes, args.Error(1)
 }
 
+func (m *OutcomeRepository) FindAllAfter(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, after *domain.Cursor, limit int) ([]domain.Outcome, error) {
+	args := m.Called(ctx, from, to, categoryId, userId, after, limit)
+
+	var outcomes []domain.Outcome
+	if args.Get(0) != nil {
+		outcomes = args.Get(0).([]domain.Outcome)
+	}
+
+	return outcomes, args.Error(1)
+}
+
 func (m *OutcomeRepository) CountAll(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) (int, error) {
 	args := m.Called(ctx, from, to, categoryId, userId)
 
@@ -55,11 +67,26 @@ func (m *OutcomeRepository) Update(ctx context.Context, o *domain.Outcome) error
 	return args.Error(0)
 }
 
-func (m *OutcomeRepository) DeleteById(ctx context.Context, id int, userId int) error {
-	args := m.Called(ctx, id, userId)
+// WithinTx runs fn against the mock itself, returning fn's error unless the
+// expectation overrides it.
+func (m *OutcomeRepository) WithinTx(ctx context.Context, fn func(repo repository.OutcomeRepository) error) error {
+	args := m.Called(ctx, fn)
+	if err := fn(m); err != nil {
+		return err
+	}
 	return args.Error(0)
 }
 
+func (m *OutcomeRepository) DeleteById(ctx context.Context, id int, userId int) (int64, error) {
+	args := m.Called(ctx, id, userId)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *OutcomeRepository) ExistsByUser(ctx context.Context, userId int) (bool, error) {
+	args := m.Called(ctx, userId)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *OutcomeRepository) GetSumByCategory(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) ([]domain.CategorySum, error) {
 	args := m.Called(ctx, from, to, categoryId, userId)
 
@@ -71,6 +98,83 @@ func (m *OutcomeRepository) GetSumByCategory(ctx context.Context, from *time.Tim
 	return sums, args.Error(1)
 }
 
+func (m *OutcomeRepository) FindOutliers(ctx context.Context, from *time.Time, to *time.Time, factor float64, userId int) ([]domain.OutcomeOutlier, error) {
+	args := m.Called(ctx, from, to, factor, userId)
+
+	var outliers []domain.OutcomeOutlier
+	if args.Get(0) != nil {
+		outliers = args.Get(0).([]domain.OutcomeOutlier)
+	}
+
+	return outliers, args.Error(1)
+}
+
+func (m *OutcomeRepository) GetSumByInterval(ctx context.Context, from time.Time, to time.Time, interval domain.Interval, tz string, userId int) ([]domain.PeriodSum, error) {
+	args := m.Called(ctx, from, to, interval, tz, userId)
+
+	var sums []domain.PeriodSum
+	if args.Get(0) != nil {
+		sums = args.Get(0).([]domain.PeriodSum)
+	}
+
+	return sums, args.Error(1)
+}
+
+func (m *OutcomeRepository) GetDailySums(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.DailySum, error) {
+	args := m.Called(ctx, from, to, tz, userId)
+
+	var sums []domain.DailySum
+	if args.Get(0) != nil {
+		sums = args.Get(0).([]domain.DailySum)
+	}
+
+	return sums, args.Error(1)
+}
+
+func (m *OutcomeRepository) GetAverageByCategory(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.CategoryAverage, error) {
+	args := m.Called(ctx, from, to, userId)
+
+	var averages []domain.CategoryAverage
+	if args.Get(0) != nil {
+		averages = args.Get(0).([]domain.CategoryAverage)
+	}
+
+	return averages, args.Error(1)
+}
+
+func (m *OutcomeRepository) GetAmountCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.AmountCount, error) {
+	args := m.Called(ctx, from, to, userId)
+
+	var counts []domain.AmountCount
+	if args.Get(0) != nil {
+		counts = args.Get(0).([]domain.AmountCount)
+	}
+
+	return counts, args.Error(1)
+}
+
+func (m *OutcomeRepository) FindFrequentNames(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.NameFrequency, error) {
+	args := m.Called(ctx, from, to, userId, limit)
+
+	var frequencies []domain.NameFrequency
+	if args.Get(0) != nil {
+		frequencies = args.Get(0).([]domain.NameFrequency)
+	}
+
+	return frequencies, args.Error(1)
+}
+
+func (m *OutcomeRepository) GetSumByHour(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.HourlySum, error) {
+	args := m.Called(ctx, from, to, tz, userId)
+
+	var sums []domain.HourlySum
+	if args.Get(0) != nil {
+		sums = args.Get(0).([]domain.HourlySum)
+	}
+
+	return sums, args.Error(1)
+}
+
 func (m *OutcomeRepository) GetTotalSum(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error) {
 	args := m.Called(ctx, from, to, userId)
 
@@ -103,3 +207,22 @@ func (m *OutcomeRepository) GetMonthlyTotalSeries(ctx context.Context, from *tim
 
 	return series, args.Error(1)
 }
+
+func (m *OutcomeRepository) GetMonthlyCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyCount, error) {
+	args := m.Called(ctx, from, to, userId)
+
+	var counts []domain.MonthlyCount
+	if args.Get(0) != nil {
+		counts = args.Get(0).([]domain.MonthlyCount)
+	}
+
+	return counts, args.Error(1)
+}
+
+func (m *OutcomeRepository) GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error) {
+	args := m.Called(ctx, userId)
+	if bounds, ok := args.Get(0).(*domain.DateBounds); ok {
+		return bounds, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
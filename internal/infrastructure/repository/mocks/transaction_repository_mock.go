@@ -0,0 +1,28 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type TransactionRepository struct {
+	mock.Mock
+}
+
+func (m *TransactionRepository) SearchByName(ctx context.Context, term string, userId int, limit int, offset int) ([]domain.Transaction, error) {
+	args := m.Called(ctx, term, userId, limit, offset)
+
+	var transactions []domain.Transaction
+	if args.Get(0) != nil {
+		transactions = args.Get(0).([]domain.Transaction)
+	}
+
+	return transactions, args.Error(1)
+}
+
+func (m *TransactionRepository) CountByName(ctx context.Context, term string, userId int) (int, error) {
+	args := m.Called(ctx, term, userId)
+	return args.Int(0), args.Error(1)
+}
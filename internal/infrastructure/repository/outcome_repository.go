@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"time"
 
@@ -11,14 +12,26 @@ import (
 type OutcomeRepository interface {
 	Create(ctx context.Context, c *domain.Outcome) error
 	FindAll(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, limit int, offset int) ([]domain.Outcome, error)
+	FindAllAfter(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, after *domain.Cursor, limit int) ([]domain.Outcome, error)
 	CountAll(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) (int, error)
 	FindById(ctx context.Context, id int, userId int) (*domain.Outcome, error)
 	Update(ctx context.Context, o *domain.Outcome) error
-	DeleteById(ctx context.Context, id int, userId int) error
+	WithinTx(ctx context.Context, fn func(repo OutcomeRepository) error) error
+	DeleteById(ctx context.Context, id int, userId int) (int64, error)
+	ExistsByUser(ctx context.Context, userId int) (bool, error)
+	GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error)
 	GetSumByCategory(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) ([]domain.CategorySum, error)
+	GetAverageByCategory(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.CategoryAverage, error)
+	GetSumByHour(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.HourlySum, error)
+	GetAmountCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.AmountCount, error)
+	FindFrequentNames(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.NameFrequency, error)
+	GetDailySums(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.DailySum, error)
+	GetSumByInterval(ctx context.Context, from time.Time, to time.Time, interval domain.Interval, tz string, userId int) ([]domain.PeriodSum, error)
+	FindOutliers(ctx context.Context, from *time.Time, to *time.Time, factor float64, userId int) ([]domain.OutcomeOutlier, error)
 	GetTotalSum(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error)
 	GetMonthlySeries(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlySeries, error)
 	GetMonthlyTotalSeries(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyTotalSeries, error)
+	GetMonthlyCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyCount, error)
 }
 
 type PostgresOutcomeRepository struct {
@@ -32,14 +45,14 @@ func NewOutcomeRepository(db DB) *PostgresOutcomeRepository {
 func (r *PostgresOutcomeRepository) Create(ctx context.Context, o *domain.Outcome) error {
 	query := `
 		INSERT INTO outcomes (name, amount, category_id, created_at, user_id)
-		VALUES ($1, $2, $3, $4, $5)
+		VALUES ($1, $2, NULLIF($3, 0), $4, $5)
 		RETURNING id
 	`
 	return r.db.QueryRow(ctx, query, o.Name, o.Amount, o.CategoryId, &o.CreatedAt, o.UserId).Scan(&o.ID)
 }
 
 func (r *PostgresOutcomeRepository) FindAll(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, limit int, offset int) ([]domain.Outcome, error) {
-	query := `SELECT id, name, amount, category_id, created_at, user_id FROM outcomes WHERE user_id = $1`
+	query := `SELECT id, name, amount, COALESCE(category_id, 0), created_at, user_id FROM outcomes WHERE user_id = $1`
 	args := []any{userId}
 	argCount := 1
 
@@ -93,6 +106,66 @@ func (r *PostgresOutcomeRepository) FindAll(ctx context.Context, from *time.Time
 	return outcomes, nil
 }
 
+// FindAllAfter returns the page of outcomes following the after cursor (the first page when nil),
+// ordered by (created_at, id) descending. Seeking on the key avoids scanning the skipped rows.
+func (r *PostgresOutcomeRepository) FindAllAfter(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, after *domain.Cursor, limit int) ([]domain.Outcome, error) {
+	query := `SELECT id, name, amount, COALESCE(category_id, 0), created_at, user_id FROM outcomes WHERE user_id = $1`
+	args := []any{userId}
+	argCount := 1
+
+	if from != nil {
+		argCount++
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
+	}
+
+	if categoryId != 0 {
+		argCount++
+		query += ` AND category_id = $` + strconv.Itoa(argCount)
+		args = append(args, categoryId)
+	}
+
+	if after != nil {
+		query += ` AND (created_at, id) < ($` + strconv.Itoa(argCount+1) + `, $` + strconv.Itoa(argCount+2) + `)`
+		argCount += 2
+		args = append(args, after.CreatedAt, after.ID)
+	}
+
+	query += ` ORDER BY created_at DESC, id DESC`
+	argCount++
+	query += ` LIMIT $` + strconv.Itoa(argCount)
+	args = append(args, limit)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var outcomes []domain.Outcome
+	for rows.Next() {
+		var o domain.Outcome
+		if err := rows.Scan(&o.ID, &o.Name, &o.Amount, &o.CategoryId, &o.CreatedAt, &o.UserId); err != nil {
+			return nil, err
+		}
+		outcomes = append(outcomes, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return outcomes, nil
+}
+
 func (r *PostgresOutcomeRepository) CountAll(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) (int, error) {
 	query := `SELECT COUNT(*) FROM outcomes WHERE user_id = $1`
 	args := []any{userId}
@@ -131,7 +204,7 @@ func (r *PostgresOutcomeRepository) FindById(ctx context.Context, id int, userId
 	var o domain.Outcome
 
 	query := `
-		SELECT id, name, amount, category_id, created_at, user_id FROM outcomes
+		SELECT id, name, amount, COALESCE(category_id, 0), created_at, user_id FROM outcomes
 		WHERE id = $1 AND user_id = $2
 	`
 
@@ -144,63 +217,278 @@ func (r *PostgresOutcomeRepository) FindById(ctx context.Context, id int, userId
 }
 
 func (r *PostgresOutcomeRepository) Update(ctx context.Context, o *domain.Outcome) error {
-	query := `UPDATE outcomes SET name = $1, amount = $2, category_id = $3, created_at = $4 WHERE id = $5 AND user_id = $6`
+	query := `UPDATE outcomes SET name = $1, amount = $2, category_id = NULLIF($3, 0), created_at = $4 WHERE id = $5 AND user_id = $6`
 
 	_, err := r.db.Exec(ctx, query, o.Name, o.Amount, o.CategoryId, o.CreatedAt, o.ID, o.UserId)
 	return err
 }
 
-func (r *PostgresOutcomeRepository) DeleteById(ctx context.Context, id int, userId int) error {
+// WithinTx runs fn with a repository bound to a transaction, committed if fn
+// succeeds and rolled back otherwise.
+func (r *PostgresOutcomeRepository) WithinTx(ctx context.Context, fn func(repo OutcomeRepository) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	// Releases the transaction should fn panic, a no-op once committed or rolled back
+	defer tx.Rollback(ctx)
+
+	if err := fn(&PostgresOutcomeRepository{db: tx}); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresOutcomeRepository) DeleteById(ctx context.Context, id int, userId int) (int64, error) {
 	query := `
 		DELETE FROM outcomes
 		WHERE id = $1 AND user_id = $2
 	`
 
-	_, err := r.db.Exec(ctx, query, id, userId)
-	return err
+	tag, err := r.db.Exec(ctx, query, id, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *PostgresOutcomeRepository) ExistsByUser(ctx context.Context, userId int) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM outcomes WHERE user_id = $1)`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, userId).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *PostgresOutcomeRepository) GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error) {
+	query := `SELECT MIN(created_at), MAX(created_at) FROM outcomes WHERE user_id = $1`
+
+	var minDate, maxDate *time.Time
+	err := r.db.QueryRow(ctx, query, userId).Scan(&minDate, &maxDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// No outcomes yet
+	if minDate == nil || maxDate == nil {
+		return nil, nil
+	}
+
+	return &domain.DateBounds{Min: *minDate, Max: *maxDate}, nil
 }
 
 func (r *PostgresOutcomeRepository) GetSumByCategory(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) ([]domain.CategorySum, error) {
+	args := []any{userId}
+	argCount := 1
+
+	// Date filters belong to the join so that categories without outcomes in
+	// the window are kept with a zero total, including a single filtered category
+	window := ""
+	if from != nil {
+		argCount++
+		window += ` AND o.created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		window += ` AND o.created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		window += ` AND o.created_at <= NOW()`
+	}
+
 	query := `
-		SELECT c.id as category_id, COALESCE(SUM(o.amount), 0) as total
+		SELECT c.id as category_id, COALESCE(SUM(o.amount), 0) as total, COUNT(o.id) as count
 		FROM categories c
-		LEFT JOIN outcomes o ON c.id = o.category_id AND c.user_id = o.user_id
+		LEFT JOIN outcomes o ON c.id = o.category_id AND c.user_id = o.user_id` + window + `
 		WHERE c.user_id = $1`
+
+	if categoryId != 0 {
+		argCount++
+		query += ` AND c.id = $` + strconv.Itoa(argCount) + ` GROUP BY c.id`
+		args = append(args, categoryId)
+	} else {
+		// Uncategorized outcomes are summed under the category id 0, a bucket
+		// only returned when the window holds some
+		query += ` GROUP BY c.id
+		UNION ALL
+		SELECT 0, SUM(o.amount), COUNT(o.id)
+		FROM outcomes o
+		WHERE o.user_id = $1 AND o.category_id IS NULL` + window + `
+		HAVING COUNT(o.id) > 0`
+	}
+
+	query += ` ORDER BY category_id`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sums []domain.CategorySum
+	for rows.Next() {
+		var s domain.CategorySum
+		if err := rows.Scan(&s.CategoryId, &s.Total, &s.Count); err != nil {
+			return nil, err
+		}
+		sums = append(sums, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sums, nil
+}
+
+func (r *PostgresOutcomeRepository) GetAverageByCategory(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.CategoryAverage, error) {
 	args := []any{userId}
 	argCount := 1
 
-	if from != nil || to != nil {
-		query += ` AND (`
-		conditionsAdded := false
+	// Date filters belong to the join so that categories without outcomes are kept
+	window := ""
+	if from != nil {
+		argCount++
+		window += ` AND o.created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		window += ` AND o.created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		window += ` AND o.created_at <= NOW()`
+	}
+
+	// Uncategorized outcomes are averaged under the category id 0, a bucket only
+	// returned when the window holds some
+	query := `
+		SELECT c.id as category_id, COALESCE(ROUND(AVG(o.amount)), 0)::int as average, COUNT(o.id) as count
+		FROM categories c
+		LEFT JOIN outcomes o ON c.id = o.category_id AND c.user_id = o.user_id` + window + `
+		WHERE c.user_id = $1 GROUP BY c.id
+		UNION ALL
+		SELECT 0, ROUND(AVG(o.amount))::int, COUNT(o.id)
+		FROM outcomes o
+		WHERE o.user_id = $1 AND o.category_id IS NULL` + window + `
+		HAVING COUNT(o.id) > 0
+		ORDER BY category_id`
 
-		if from != nil {
-			argCount++
-			query += `o.created_at >= $` + strconv.Itoa(argCount)
-			args = append(args, *from)
-			conditionsAdded = true
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var averages []domain.CategoryAverage
+	for rows.Next() {
+		var a domain.CategoryAverage
+		if err := rows.Scan(&a.CategoryId, &a.Average, &a.Count); err != nil {
+			return nil, err
 		}
+		averages = append(averages, a)
+	}
 
-		if to != nil {
-			if conditionsAdded {
-				query += ` AND `
-			}
-			argCount++
-			query += `o.created_at <= $` + strconv.Itoa(argCount)
-			args = append(args, *to)
-		} else if from != nil {
-			query += ` AND o.created_at <= NOW()`
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return averages, nil
+}
+
+// FindOutliers returns the outcomes whose amount is greater than factor times
+// the average of their category, both computed over the same window.
+// Uncategorized outcomes are compared with the average of uncategorized outcomes.
+func (r *PostgresOutcomeRepository) FindOutliers(ctx context.Context, from *time.Time, to *time.Time, factor float64, userId int) ([]domain.OutcomeOutlier, error) {
+	query := `
+		WITH windowed AS (
+			SELECT id, name, amount, category_id, created_at, user_id FROM outcomes WHERE user_id = $1`
+	args := []any{userId, factor}
+	argCount := 2
+
+	if from != nil {
+		argCount++
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
+	}
+
+	query += `
+		), averages AS (
+			SELECT category_id, AVG(amount) AS average FROM windowed GROUP BY category_id
+		)
+		SELECT w.id, w.name, w.amount, COALESCE(w.category_id, 0), w.created_at, w.user_id, ROUND(a.average)::int
+		FROM windowed w
+		JOIN averages a ON a.category_id IS NOT DISTINCT FROM w.category_id
+		WHERE w.amount > a.average * $2
+		ORDER BY w.amount DESC, w.id DESC`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var outliers []domain.OutcomeOutlier
+	for rows.Next() {
+		var o domain.OutcomeOutlier
+		if err := rows.Scan(&o.ID, &o.Name, &o.Amount, &o.CategoryId, &o.CreatedAt, &o.UserId, &o.CategoryAverage); err != nil {
+			return nil, err
 		}
+		outliers = append(outliers, o)
+	}
 
-		query += `)`
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	if categoryId != 0 {
+	return outliers, nil
+}
+
+// GetSumByHour returns the total of outcomes for each hour of the day (0-23) in the
+// given IANA timezone. Hours without outcomes are zero-filled.
+func (r *PostgresOutcomeRepository) GetSumByHour(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.HourlySum, error) {
+	query := `
+		SELECT EXTRACT(HOUR FROM created_at AT TIME ZONE $1)::int as hour, COALESCE(SUM(amount), 0) as total
+		FROM outcomes
+		WHERE user_id = $2`
+	args := []any{tz, userId}
+	argCount := 2
+
+	if from != nil {
 		argCount++
-		query += ` AND c.id = $` + strconv.Itoa(argCount)
-		args = append(args, categoryId)
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
 	}
 
-	query += ` GROUP BY c.id ORDER BY c.id`
+	query += ` GROUP BY hour ORDER BY hour`
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
@@ -208,10 +496,131 @@ func (r *PostgresOutcomeRepository) GetSumByCategory(ctx context.Context, from *
 	}
 	defer rows.Close()
 
-	var sums []domain.CategorySum
+	sums := make([]domain.HourlySum, 24)
+	for hour := range sums {
+		sums[hour].Hour = hour
+	}
+
 	for rows.Next() {
-		var s domain.CategorySum
-		if err := rows.Scan(&s.CategoryId, &s.Total); err != nil {
+		var hour, total int
+		if err := rows.Scan(&hour, &total); err != nil {
+			return nil, err
+		}
+		if hour >= 0 && hour < len(sums) {
+			sums[hour].Total = total
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sums, nil
+}
+
+// GetDailySums returns the total of outcomes for each day, in the tz timezone, having at least one outcome.
+func (r *PostgresOutcomeRepository) GetDailySums(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.DailySum, error) {
+	query := `
+		SELECT to_char((created_at AT TIME ZONE $1)::date, 'YYYY-MM-DD') as day, COALESCE(SUM(amount), 0) as total
+		FROM outcomes
+		WHERE user_id = $2`
+	args := []any{tz, userId}
+	argCount := 2
+
+	if from != nil {
+		argCount++
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
+	}
+
+	query += ` GROUP BY day ORDER BY day`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sums []domain.DailySum
+	for rows.Next() {
+		var s domain.DailySum
+		if err := rows.Scan(&s.Day, &s.Total); err != nil {
+			return nil, err
+		}
+		sums = append(sums, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sums, nil
+}
+
+// intervalSteps maps each interval to its date_trunc unit and to the step between
+// two periods, the unit not always being a valid interval (ex: quarter).
+var intervalSteps = map[domain.Interval]struct{ unit, step string }{
+	domain.IntervalDay:     {"day", "1 day"},
+	domain.IntervalWeek:    {"week", "1 week"},
+	domain.IntervalMonth:   {"month", "1 month"},
+	domain.IntervalQuarter: {"quarter", "3 months"},
+	domain.IntervalYear:    {"year", "1 year"},
+}
+
+// GetSumByInterval returns the total of outcomes of each period of the interval
+// between from and to, periods being taken in the tz timezone. Periods without
+// outcomes are included with a zero total.
+func (r *PostgresOutcomeRepository) GetSumByInterval(ctx context.Context, from time.Time, to time.Time, interval domain.Interval, tz string, userId int) ([]domain.PeriodSum, error) {
+	steps, ok := intervalSteps[interval]
+	if !ok {
+		return nil, errors.New("unsupported interval " + string(interval))
+	}
+
+	query := `
+		WITH periods AS (
+			SELECT generate_series(
+				date_trunc($1, $3::timestamptz AT TIME ZONE $2),
+				date_trunc($1, $4::timestamptz AT TIME ZONE $2),
+				$5::interval
+			) AS period
+		),
+		agg_outcomes AS (
+			SELECT
+				date_trunc($1, created_at AT TIME ZONE $2) AS period,
+				SUM(amount) AS total,
+				COUNT(*) AS count
+			FROM outcomes
+			WHERE user_id = $6 AND created_at >= $3 AND created_at <= $4
+			GROUP BY 1
+		)
+		SELECT
+			to_char(p.period, 'YYYY-MM-DD') AS start,
+			COALESCE(a.total, 0) AS total,
+			COALESCE(a.count, 0) AS count
+		FROM periods p
+		LEFT JOIN agg_outcomes a
+			ON a.period = p.period
+		ORDER BY p.period
+	`
+
+	rows, err := r.db.Query(ctx, query, steps.unit, tz, from, to, steps.step, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sums []domain.PeriodSum
+	for rows.Next() {
+		var s domain.PeriodSum
+		if err := rows.Scan(&s.Start, &s.Total, &s.Count); err != nil {
 			return nil, err
 		}
 		sums = append(sums, s)
@@ -252,6 +661,8 @@ func (r *PostgresOutcomeRepository) GetTotalSum(ctx context.Context, from *time.
 	return total, nil
 }
 
+// GetMonthlySeries returns the outcomes total of each category for every month
+// between from and to. Uncategorized outcomes are summed under the category id 0.
 func (r *PostgresOutcomeRepository) GetMonthlySeries(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlySeries, error) {
 	query := `
 		WITH months AS (
@@ -261,19 +672,26 @@ func (r *PostgresOutcomeRepository) GetMonthlySeries(ctx context.Context, from *
 				interval '1 month'
 			) AS month
 		),
-		user_categories AS (
-			SELECT id
-			FROM categories
-			WHERE user_id = $3
-		),
 		agg_outcomes AS (
 			SELECT
 				date_trunc('month', o.month) AS month,
-				o.category_id,
+				COALESCE(o.category_id, 0) AS category_id,
 				SUM(o.amount) AS total
 			FROM outcomes o
 			WHERE o.user_id = $3
-			GROUP BY date_trunc('month', o.month), o.category_id
+			GROUP BY date_trunc('month', o.month), COALESCE(o.category_id, 0)
+		),
+		user_categories AS (
+			SELECT id
+			FROM categories
+			WHERE user_id = $3
+			UNION ALL
+			SELECT 0
+			WHERE EXISTS (
+				SELECT 1 FROM agg_outcomes a
+				WHERE a.category_id = 0
+				AND a.month BETWEEN date_trunc('month', $1::date) AND date_trunc('month', $2::date)
+			)
 		)
 		SELECT
 			to_char(m.month, 'YYYY-MM') AS month,
@@ -393,3 +811,149 @@ func (r *PostgresOutcomeRepository) GetMonthlyTotalSeries(ctx context.Context, f
 
 	return series, nil
 }
+
+// GetMonthlyCounts returns the number of outcomes of each month between from and
+// to, months without outcomes included with a zero count.
+func (r *PostgresOutcomeRepository) GetMonthlyCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyCount, error) {
+	query := `
+		WITH months AS (
+			SELECT generate_series(
+				date_trunc('month', $1::date),
+				date_trunc('month', $2::date),
+				interval '1 month'
+			) AS month
+		),
+		agg_outcomes AS (
+			SELECT
+				date_trunc('month', o.month) AS month,
+				COUNT(o.id) AS count
+			FROM outcomes o
+			WHERE o.user_id = $3
+			GROUP BY date_trunc('month', o.month)
+		)
+		SELECT
+			to_char(m.month, 'YYYY-MM') AS month,
+			COALESCE(a.count, 0)::int AS count
+		FROM months m
+		LEFT JOIN agg_outcomes a
+			ON a.month = m.month
+		ORDER BY m.month
+	`
+
+	rows, err := r.db.Query(ctx, query, *from, *to, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []domain.MonthlyCount
+	for rows.Next() {
+		var c domain.MonthlyCount
+		if err := rows.Scan(&c.Month, &c.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// GetAmountCounts returns the number of outcomes of each distinct amount, by
+// ascending amount.
+func (r *PostgresOutcomeRepository) GetAmountCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.AmountCount, error) {
+	query := `
+		SELECT amount, COUNT(*)
+		FROM outcomes
+		WHERE user_id = $1`
+	args := []any{userId}
+	argCount := 1
+
+	if from != nil {
+		argCount++
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
+	}
+
+	query += ` GROUP BY amount ORDER BY amount`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []domain.AmountCount
+	for rows.Next() {
+		var c domain.AmountCount
+		if err := rows.Scan(&c.Amount, &c.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// FindFrequentNames returns the most frequent outcome names with their count and
+// total, by descending count then descending total.
+func (r *PostgresOutcomeRepository) FindFrequentNames(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.NameFrequency, error) {
+	query := `SELECT name, COUNT(*), SUM(amount) FROM outcomes WHERE user_id = $1`
+	args := []any{userId}
+	argCount := 1
+
+	if from != nil {
+		argCount++
+		query += ` AND created_at >= $` + strconv.Itoa(argCount)
+		args = append(args, *from)
+	}
+
+	if to != nil {
+		argCount++
+		query += ` AND created_at <= $` + strconv.Itoa(argCount)
+		args = append(args, *to)
+	} else {
+		query += ` AND created_at <= NOW()`
+	}
+
+	query += ` GROUP BY name ORDER BY COUNT(*) DESC, SUM(amount) DESC, name`
+	argCount++
+	query += ` LIMIT $` + strconv.Itoa(argCount)
+	args = append(args, limit)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var frequencies []domain.NameFrequency
+	for rows.Next() {
+		var f domain.NameFrequency
+		if err := rows.Scan(&f.Name, &f.Count, &f.Total); err != nil {
+			return nil, err
+		}
+		frequencies = append(frequencies, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return frequencies, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -65,6 +66,70 @@ func TestPostgresOutcomeRepository_FindAll(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestPostgresOutcomeRepository_FindAllAfter_PagesWithoutGapsOrOverlaps(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	// Sorted by (created_at, id) descending, with ties on created_at that only
+	// the id tie-breaker can separate
+	t1 := time.Date(2026, 1, 3, 12, 0, 0, 500, time.UTC)
+	t2 := time.Date(2026, 1, 2, 9, 30, 0, 0, time.UTC)
+	t3 := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	dataset := []domain.Outcome{
+		{ID: 7, Name: "Cinema", Amount: 1200, CategoryId: 1, CreatedAt: &t1, UserId: 123},
+		{ID: 5, Name: "Bakery", Amount: 350, CategoryId: 2, CreatedAt: &t1, UserId: 123},
+		{ID: 6, Name: "Fuel", Amount: 6000, CategoryId: 3, CreatedAt: &t2, UserId: 123},
+		{ID: 4, Name: "Books", Amount: 2500, CategoryId: 1, CreatedAt: &t2, UserId: 123},
+		{ID: 2, Name: "Rent", Amount: 90000, CategoryId: 4, CreatedAt: &t3, UserId: 123},
+	}
+	limit := 2
+
+	// isAfter mirrors the (created_at, id) < (cursor) condition of the query
+	isAfter := func(o domain.Outcome, c *domain.Cursor) bool {
+		return c == nil || o.CreatedAt.Before(c.CreatedAt) || (o.CreatedAt.Equal(c.CreatedAt) && o.ID < c.ID)
+	}
+
+	var after *domain.Cursor
+	var seen []int
+	for range len(dataset) {
+		rows := pgxmock.NewRows([]string{"id", "name", "amount", "category_id", "created_at", "user_id"})
+		n := 0
+		for _, o := range dataset {
+			if isAfter(o, after) && n < limit {
+				rows.AddRow(o.ID, o.Name, o.Amount, o.CategoryId, o.CreatedAt, o.UserId)
+				n++
+			}
+		}
+
+		if after == nil {
+			mock.ExpectQuery("SELECT (.+) FROM outcomes WHERE user_id = \\$1 AND created_at <= NOW\\(\\) ORDER BY created_at DESC, id DESC LIMIT \\$2").
+				WithArgs(123, limit).
+				WillReturnRows(rows)
+		} else {
+			mock.ExpectQuery("SELECT (.+) FROM outcomes WHERE user_id = \\$1 AND created_at <= NOW\\(\\) AND \\(created_at, id\\) < \\(\\$2, \\$3\\) ORDER BY created_at DESC, id DESC LIMIT \\$4").
+				WithArgs(123, after.CreatedAt, after.ID, limit).
+				WillReturnRows(rows)
+		}
+
+		page, err := repo.FindAllAfter(context.Background(), nil, nil, 0, 123, after, limit)
+		assert.NoError(t, err)
+
+		for _, o := range page {
+			seen = append(seen, o.ID)
+		}
+		if len(page) < limit {
+			break
+		}
+		last := page[len(page)-1]
+		after = &domain.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}
+	}
+
+	assert.Equal(t, []int{7, 5, 6, 4, 2}, seen)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestPostgresOutcomeRepository_CountAll(t *testing.T) {
 	mock, _ := pgxmock.NewPool()
 	defer mock.Close()
@@ -141,9 +206,27 @@ func TestPostgresOutcomeRepository_DeleteById(t *testing.T) {
 		WithArgs(1, 123).
 		WillReturnResult(pgxmock.NewResult("DELETE", 1))
 
-	err := repo.DeleteById(context.Background(), 1, 123)
+	deleted, err := repo.DeleteById(context.Background(), 1, 123)
 
 	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_DeleteById_NotFound(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	mock.ExpectExec("DELETE FROM outcomes").
+		WithArgs(99, 123).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	deleted, err := repo.DeleteById(context.Background(), 99, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -153,24 +236,267 @@ func TestPostgresOutcomeRepository_GetSumByCategory(t *testing.T) {
 
 	repo := NewOutcomeRepository(mock)
 
-	rows := pgxmock.NewRows([]string{"category_id", "total"}).
-		AddRow(1, 1000).
-		AddRow(2, 2000)
+	rows := pgxmock.NewRows([]string{"category_id", "total", "count"}).
+		AddRow(1, 1000, 3).
+		AddRow(2, 2000, 1).
+		AddRow(3, 0, 0)
 
-	mock.ExpectQuery("SELECT (.+) FROM categories").
+	// Counting o.id rather than rows gives categories without outcomes a zero count
+	mock.ExpectQuery("SELECT (.+) COUNT\\(o.id\\) as count FROM categories").
 		WithArgs(123).
 		WillReturnRows(rows)
 
 	sums, err := repo.GetSumByCategory(context.Background(), nil, nil, 0, 123)
 
 	assert.NoError(t, err)
-	assert.Len(t, sums, 2)
-	assert.Equal(t, 1, sums[0].CategoryId)
-	assert.Equal(t, 1000, sums[0].Total)
+	assert.Equal(t, []domain.CategorySum{
+		{CategoryId: 1, Total: 1000, Count: 3},
+		{CategoryId: 2, Total: 2000, Count: 1},
+		{CategoryId: 3, Total: 0, Count: 0},
+	}, sums)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestPostgresOutcomeRepository_GetSumByCategory_AllTimeBoundedLikeTotal(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	// Without dates, the sums by category and the total both stop at now, so
+	// that their all time figures add up
+	mock.ExpectQuery("LEFT JOIN outcomes o ON c.id = o.category_id AND c.user_id = o.user_id AND o.created_at <= NOW\\(\\) WHERE c.user_id = \\$1 GROUP BY c.id (.+) AND o.category_id IS NULL AND o.created_at <= NOW\\(\\) HAVING").
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"category_id", "total", "count"}).
+			AddRow(0, 500, 1).
+			AddRow(1, 1000, 3).
+			AddRow(2, 1500, 2))
+	mock.ExpectQuery("SELECT (.+) FROM outcomes WHERE user_id = \\$1 AND created_at <= NOW\\(\\)").
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"total"}).AddRow(3000))
+
+	sums, err := repo.GetSumByCategory(context.Background(), nil, nil, 0, 123)
+	assert.NoError(t, err)
+	total, err := repo.GetTotalSum(context.Background(), nil, nil, 123)
+	assert.NoError(t, err)
+
+	sum := 0
+	for _, s := range sums {
+		sum += s.Total
+	}
+	assert.Equal(t, total, sum)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetSumByCategory_Uncategorized(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"category_id", "total", "count"}).
+		AddRow(0, 1500, 2).
+		AddRow(1, 1000, 3)
+
+	// Outcomes without category are summed apart, with the same window, under the id 0
+	mock.ExpectQuery("GROUP BY c.id UNION ALL SELECT 0, SUM\\(o.amount\\), COUNT\\(o.id\\) FROM outcomes o WHERE o.user_id = \\$1 AND o.category_id IS NULL AND o.created_at >= \\$2 AND o.created_at <= \\$3 HAVING COUNT\\(o.id\\) > 0 ORDER BY category_id").
+		WithArgs(123, from, to).
+		WillReturnRows(rows)
+
+	sums, err := repo.GetSumByCategory(context.Background(), &from, &to, 0, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CategorySum{
+		{CategoryId: 0, Total: 1500, Count: 2},
+		{CategoryId: 1, Total: 1000, Count: 3},
+	}, sums)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetSumByCategory_FilteredCategoryWithoutOutcomes(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"category_id", "total", "count"}).
+		AddRow(4, 0, 0)
+
+	// The date filters sit in the join condition, not in the WHERE clause,
+	// so the filtered category is kept even without outcomes in the window
+	mock.ExpectQuery("SELECT (.+) FROM categories c LEFT JOIN outcomes o ON c.id = o.category_id AND c.user_id = o.user_id AND o.created_at >= \\$2 AND o.created_at <= \\$3 WHERE c.user_id = \\$1 AND c.id = \\$4 GROUP BY c.id").
+		WithArgs(123, from, to, 4).
+		WillReturnRows(rows)
+
+	sums, err := repo.GetSumByCategory(context.Background(), &from, &to, 4, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CategorySum{{CategoryId: 4, Total: 0}}, sums)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetAverageByCategory(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"category_id", "average", "count"}).
+		AddRow(1, 250, 8).
+		AddRow(2, 12000, 1).
+		AddRow(3, 0, 0)
+
+	mock.ExpectQuery("SELECT (.+)AVG(.+) FROM categories c LEFT JOIN outcomes o ON (.+) WHERE c.user_id = \\$1 GROUP BY c.id").
+		WithArgs(123, from, to).
+		WillReturnRows(rows)
+
+	averages, err := repo.GetAverageByCategory(context.Background(), &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CategoryAverage{
+		{CategoryId: 1, Average: 250, Count: 8},
+		{CategoryId: 2, Average: 12000, Count: 1},
+		{CategoryId: 3, Average: 0, Count: 0},
+	}, averages)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_FindOutliers(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+	createdAt := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+
+	// Category 3 holds a 899.00 TV, a 25.00 cable and 12.00 batteries: only
+	// the TV exceeds twice the category average (312.00) and is returned
+	rows := pgxmock.NewRows([]string{"id", "name", "amount", "category_id", "created_at", "user_id", "average"}).
+		AddRow(9, "TV", 89900, 3, &createdAt, 123, 31200)
+
+	mock.ExpectQuery("WITH windowed AS (.+) FROM outcomes WHERE user_id = \\$1 (.+) averages AS (.+)AVG\\(amount\\)(.+) JOIN averages a ON a.category_id IS NOT DISTINCT FROM w.category_id WHERE w.amount > a.average \\* \\$2").
+		WithArgs(123, 2.0, from, to).
+		WillReturnRows(rows)
+
+	outliers, err := repo.FindOutliers(context.Background(), &from, &to, 2.0, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.OutcomeOutlier{
+		{Outcome: domain.Outcome{ID: 9, Name: "TV", Amount: 89900, CategoryId: 3, CreatedAt: &createdAt, UserId: 123}, CategoryAverage: 31200},
+	}, outliers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetDailySums(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"day", "total"}).
+		AddRow("2026-01-02", 1500).
+		AddRow("2026-01-05", 300)
+
+	mock.ExpectQuery("SELECT to_char\\(\\(created_at AT TIME ZONE \\$1\\)::date, 'YYYY-MM-DD'\\) as day(.+) WHERE user_id = \\$2 (.+) GROUP BY day ORDER BY day").
+		WithArgs("Europe/Paris", 123, from, to).
+		WillReturnRows(rows)
+
+	sums, err := repo.GetDailySums(context.Background(), &from, &to, "Europe/Paris", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.DailySum{
+		{Day: "2026-01-02", Total: 1500},
+		{Day: "2026-01-05", Total: 300},
+	}, sums)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetSumByInterval_Quarter(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"start", "total", "count"}).
+		AddRow("2026-01-01", 4500, 3).
+		AddRow("2026-04-01", 0, 0).
+		AddRow("2026-07-01", 1200, 1)
+
+	mock.ExpectQuery(`WITH periods AS (.+)generate_series\( date_trunc\(\$1, \$3::timestamptz AT TIME ZONE \$2\), date_trunc\(\$1, \$4::timestamptz AT TIME ZONE \$2\), \$5::interval \)(.+)WHERE user_id = \$6(.+)ORDER BY p.period`).
+		WithArgs("quarter", "Europe/Paris", from, to, "3 months", 123).
+		WillReturnRows(rows)
+
+	sums, err := repo.GetSumByInterval(context.Background(), from, to, domain.IntervalQuarter, "Europe/Paris", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PeriodSum{
+		{Start: "2026-01-01", Total: 4500, Count: 3},
+		{Start: "2026-04-01", Total: 0, Count: 0},
+		{Start: "2026-07-01", Total: 1200, Count: 1},
+	}, sums)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetSumByInterval_Year(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"start", "total", "count"}).
+		AddRow("2024-01-01", 10000, 4).
+		AddRow("2025-01-01", 25000, 9).
+		AddRow("2026-01-01", 3000, 2)
+
+	mock.ExpectQuery(`WITH periods AS (.+)date_trunc\(\$1, created_at AT TIME ZONE \$2\) AS period(.+)ORDER BY p.period`).
+		WithArgs("year", "UTC", from, to, "1 year", 123).
+		WillReturnRows(rows)
+
+	sums, err := repo.GetSumByInterval(context.Background(), from, to, domain.IntervalYear, "UTC", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PeriodSum{
+		{Start: "2024-01-01", Total: 10000, Count: 4},
+		{Start: "2025-01-01", Total: 25000, Count: 9},
+		{Start: "2026-01-01", Total: 3000, Count: 2},
+	}, sums)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetSumByInterval_UnsupportedInterval(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	sums, err := repo.GetSumByInterval(context.Background(), time.Now(), time.Now(), domain.Interval("hour; DROP TABLE outcomes"), "UTC", 123)
+
+	assert.Nil(t, sums)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestPostgresOutcomeRepository_GetTotalSum(t *testing.T) {
 	mock, _ := pgxmock.NewPool()
 	defer mock.Close()
@@ -226,6 +552,35 @@ func TestPostgresOutcomeRepository_GetMonthlySeries(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestPostgresOutcomeRepository_GetMonthlySeries_Uncategorized(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"month", "category_id", "total"}).
+		AddRow("2023-01", 0, 500).
+		AddRow("2023-01", 1, 1000).
+		AddRow("2023-02", 0, 0).
+		AddRow("2023-02", 1, 2000)
+
+	mock.ExpectQuery("COALESCE\\(o.category_id, 0\\) AS category_id(.+)UNION ALL SELECT 0 WHERE EXISTS").
+		WithArgs(from, to, 123).
+		WillReturnRows(rows)
+
+	series, err := repo.GetMonthlySeries(context.Background(), &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.MonthlySeries{
+		{Month: "2023-01", Categories: map[int]int{0: 500, 1: 1000}},
+		{Month: "2023-02", Categories: map[int]int{0: 0, 1: 2000}},
+	}, series)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestPostgresOutcomeRepository_GetMonthlyTotalSeries(t *testing.T) {
 	mock, _ := pgxmock.NewPool()
 	defer mock.Close()
@@ -254,3 +609,239 @@ func TestPostgresOutcomeRepository_GetMonthlyTotalSeries(t *testing.T) {
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestPostgresOutcomeRepository_GetMonthlyCounts(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"month", "count"}).
+		AddRow("2023-01", 12).
+		AddRow("2023-02", 0).
+		AddRow("2023-03", 3).
+		AddRow("2023-04", 0)
+
+	mock.ExpectQuery("WITH months AS (.+) COUNT\\(o.id\\)").
+		WithArgs(from, to, 123).
+		WillReturnRows(rows)
+
+	counts, err := repo.GetMonthlyCounts(context.Background(), &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.MonthlyCount{
+		{Month: "2023-01", Count: 12},
+		{Month: "2023-02", Count: 0},
+		{Month: "2023-03", Count: 3},
+		{Month: "2023-04", Count: 0},
+	}, counts)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_ExistsByUser(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	mock.ExpectQuery("SELECT EXISTS (.+) FROM outcomes").
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := repo.ExistsByUser(context.Background(), 123)
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetDateBounds(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	minDate := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2026, 9, 30, 18, 30, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT MIN\\(created_at\\), MAX\\(created_at\\) FROM outcomes").
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"min", "max"}).AddRow(&minDate, &maxDate))
+
+	bounds, err := repo.GetDateBounds(context.Background(), 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.DateBounds{Min: minDate, Max: maxDate}, bounds)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetDateBounds_Empty(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	mock.ExpectQuery("SELECT MIN\\(created_at\\), MAX\\(created_at\\) FROM outcomes").
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"min", "max"}).AddRow(nil, nil))
+
+	bounds, err := repo.GetDateBounds(context.Background(), 123)
+
+	assert.NoError(t, err)
+	assert.Nil(t, bounds)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_WithinTx_Commit(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE outcomes").
+		WithArgs("Lunch", 1500, 1, pgxmock.AnyArg(), 1, 123).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+
+	err := repo.WithinTx(context.Background(), func(txRepo OutcomeRepository) error {
+		return txRepo.Update(context.Background(), &domain.Outcome{ID: 1, UserId: 123, Name: "Lunch", Amount: 1500, CategoryId: 1})
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_WithinTx_RollbackOnPanic(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	assert.PanicsWithValue(t, "boom", func() {
+		repo.WithinTx(context.Background(), func(txRepo OutcomeRepository) error {
+			panic("boom")
+		})
+	})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_WithinTx_Rollback(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE outcomes").
+		WithArgs("Lunch", 1500, 1, pgxmock.AnyArg(), 1, 123).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectRollback()
+
+	fnErr := errors.New("second item failed")
+	err := repo.WithinTx(context.Background(), func(txRepo OutcomeRepository) error {
+		if err := txRepo.Update(context.Background(), &domain.Outcome{ID: 1, UserId: 123, Name: "Lunch", Amount: 1500, CategoryId: 1}); err != nil {
+			return err
+		}
+		return fnErr
+	})
+
+	assert.ErrorIs(t, err, fnErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_FindFrequentNames(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"name", "count", "sum"}).
+		AddRow("Bakery", 12, 3600).
+		AddRow("Supermarket", 4, 32000).
+		AddRow("Cinema", 4, 4800)
+
+	mock.ExpectQuery(`SELECT name, COUNT\(\*\), SUM\(amount\) FROM outcomes WHERE user_id = \$1 AND created_at >= \$2 AND created_at <= \$3 GROUP BY name ORDER BY COUNT\(\*\) DESC, SUM\(amount\) DESC, name LIMIT \$4`).
+		WithArgs(123, from, to, 10).
+		WillReturnRows(rows)
+
+	frequencies, err := repo.FindFrequentNames(context.Background(), &from, &to, 123, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.NameFrequency{
+		{Name: "Bakery", Count: 12, Total: 3600},
+		{Name: "Supermarket", Count: 4, Total: 32000},
+		{Name: "Cinema", Count: 4, Total: 4800},
+	}, frequencies)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetAmountCounts(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"amount", "count"}).
+		AddRow(500, 3).
+		AddRow(1200, 1)
+
+	mock.ExpectQuery(`SELECT amount, COUNT\(\*\) FROM outcomes WHERE user_id = \$1 AND created_at >= \$2 AND created_at <= \$3 GROUP BY amount ORDER BY amount`).
+		WithArgs(123, from, to).
+		WillReturnRows(rows)
+
+	counts, err := repo.GetAmountCounts(context.Background(), &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.AmountCount{{Amount: 500, Count: 3}, {Amount: 1200, Count: 1}}, counts)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutcomeRepository_GetSumByHour(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewOutcomeRepository(mock)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	// Hours are extracted in the requested timezone by the database
+	rows := pgxmock.NewRows([]string{"hour", "total"}).
+		AddRow(0, 1500).
+		AddRow(9, 1200).
+		AddRow(23, 800)
+
+	mock.ExpectQuery(`EXTRACT\(HOUR FROM created_at AT TIME ZONE \$1\)`).
+		WithArgs("Europe/Paris", 123, from, to).
+		WillReturnRows(rows)
+
+	sums, err := repo.GetSumByHour(context.Background(), &from, &to, "Europe/Paris", 123)
+
+	assert.NoError(t, err)
+	assert.Len(t, sums, 24)
+	for hour, sum := range sums {
+		assert.Equal(t, hour, sum.Hour)
+	}
+	assert.Equal(t, 1500, sums[0].Total)
+	assert.Equal(t, 1200, sums[9].Total)
+	assert.Equal(t, 800, sums[23].Total)
+	assert.Equal(t, 0, sums[12].Total)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
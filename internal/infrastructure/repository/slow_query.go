@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Warner receives the slow query warnings, such as *logger.Logger.
+type Warner interface {
+	Warn(v ...any)
+}
+
+// SlowQueryDB decorates a DB to log a warning for each query taking longer than
+// a threshold, named after the repository method that ran it. Queries are timed
+// until their first result, reading the rows of a Query is not included.
+type SlowQueryDB struct {
+	db        DB
+	threshold time.Duration
+	logger    Warner
+}
+
+func NewSlowQueryDB(db DB, threshold time.Duration, logger Warner) *SlowQueryDB {
+	return &SlowQueryDB{db: db, threshold: threshold, logger: logger}
+}
+
+func (d *SlowQueryDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	defer d.observe(time.Now())
+	return d.db.QueryRow(ctx, sql, args...)
+}
+
+func (d *SlowQueryDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	defer d.observe(time.Now())
+	return d.db.Query(ctx, sql, args...)
+}
+
+func (d *SlowQueryDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	defer d.observe(time.Now())
+	return d.db.Exec(ctx, sql, args...)
+}
+
+// Begin starts a transaction whose queries are timed as well.
+func (d *SlowQueryDB) Begin(ctx context.Context) (pgx.Tx, error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &slowQueryTx{Tx: tx, observer: d}, nil
+}
+
+// observe warns when the query started at start was slow. It must be deferred
+// by the query methods so the caller two frames up is the repository method.
+func (d *SlowQueryDB) observe(start time.Time) {
+	elapsed := time.Since(start)
+	if elapsed <= d.threshold {
+		return
+	}
+	d.logger.Warn("slow query", operation(3), "took", elapsed.Round(time.Millisecond))
+}
+
+// operation names the function skip frames up the stack, such as
+// "(*PostgresOutcomeRepository).FindAll".
+func operation(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	_, name, _ = strings.Cut(name, ".")
+	return name
+}
+
+// slowQueryTx times the queries run within a transaction.
+type slowQueryTx struct {
+	pgx.Tx
+	observer *SlowQueryDB
+}
+
+func (t *slowQueryTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	defer t.observer.observe(time.Now())
+	return t.Tx.QueryRow(ctx, sql, args...)
+}
+
+func (t *slowQueryTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	defer t.observer.observe(time.Now())
+	return t.Tx.Query(ctx, sql, args...)
+}
+
+func (t *slowQueryTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	defer t.observer.observe(time.Now())
+	return t.Tx.Exec(ctx, sql, args...)
+}
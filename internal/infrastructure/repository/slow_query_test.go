@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeWarner struct {
+	warnings []string
+}
+
+func (w *fakeWarner) Warn(v ...any) {
+	w.warnings = append(w.warnings, fmt.Sprintln(v...))
+}
+
+func TestSlowQueryDB_LogsSlowQuery(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	warner := &fakeWarner{}
+	repo := NewCategoryRepository(NewSlowQueryDB(mock, 10*time.Millisecond, warner))
+
+	mock.ExpectQuery(`SELECT (.+) FROM categories WHERE user_id = \$1`).
+		WithArgs(123).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "label", "color", "user_id"})).
+		WillDelayFor(30 * time.Millisecond)
+
+	_, err := repo.FindAll(context.Background(), 123)
+
+	assert.NoError(t, err)
+	if assert.Len(t, warner.warnings, 1) {
+		assert.Contains(t, warner.warnings[0], "slow query (*PostgresCategoryRepository).FindAll took")
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlowQueryDB_IgnoresFastQuery(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	warner := &fakeWarner{}
+	repo := NewCategoryRepository(NewSlowQueryDB(mock, time.Second, warner))
+
+	mock.ExpectExec(`DELETE FROM categories`).
+		WithArgs(1, 123).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	err := repo.DeleteById(context.Background(), 1, 123)
+
+	assert.NoError(t, err)
+	assert.Empty(t, warner.warnings)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlowQueryDB_LogsSlowQueryWithinTx(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	warner := &fakeWarner{}
+	repo := NewOutcomeRepository(NewSlowQueryDB(mock, 10*time.Millisecond, warner))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE outcomes`).
+		WithArgs("", 0, 0, (*time.Time)(nil), 1, 123).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1)).
+		WillDelayFor(30 * time.Millisecond)
+	mock.ExpectCommit()
+
+	err := repo.WithinTx(context.Background(), func(tx OutcomeRepository) error {
+		return tx.Update(context.Background(), &domain.Outcome{ID: 1, UserId: 123})
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, warner.warnings, 1) {
+		assert.Contains(t, warner.warnings[0], "slow query (*PostgresOutcomeRepository).Update took")
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
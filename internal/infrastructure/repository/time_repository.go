@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TimeRepository interface {
+	Now(ctx context.Context) (time.Time, error)
+}
+
+type PostgresTimeRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTimeRepository(db *pgxpool.Pool) *PostgresTimeRepository {
+	return &PostgresTimeRepository{db: db}
+}
+
+func (r *PostgresTimeRepository) Now(ctx context.Context) (time.Time, error) {
+	var now time.Time
+	err := r.db.QueryRow(ctx, `SELECT NOW()`).Scan(&now)
+	return now, err
+}
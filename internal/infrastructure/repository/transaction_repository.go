@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kerhael/accounting/internal/domain"
+)
+
+type TransactionRepository interface {
+	SearchByName(ctx context.Context, term string, userId int, limit int, offset int) ([]domain.Transaction, error)
+	CountByName(ctx context.Context, term string, userId int) (int, error)
+}
+
+type PostgresTransactionRepository struct {
+	db DB
+}
+
+func NewTransactionRepository(db DB) *PostgresTransactionRepository {
+	return &PostgresTransactionRepository{db: db}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern builds an ILIKE pattern matching term anywhere, LIKE
+// metacharacters in term being matched literally.
+func containsPattern(term string) string {
+	return "%" + likeEscaper.Replace(term) + "%"
+}
+
+func (r *PostgresTransactionRepository) SearchByName(ctx context.Context, term string, userId int, limit int, offset int) ([]domain.Transaction, error) {
+	query := `
+		SELECT 'outcome' as type, id, name, amount, COALESCE(category_id, 0) as category_id, created_at
+		FROM outcomes
+		WHERE user_id = $1 AND name ILIKE $2 ESCAPE '\'
+		UNION ALL
+		SELECT 'income' as type, id, name, amount, 0 as category_id, created_at
+		FROM incomes
+		WHERE user_id = $1 AND name ILIKE $2 ESCAPE '\'
+		ORDER BY created_at DESC, type, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(ctx, query, userId, containsPattern(term), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []domain.Transaction
+	for rows.Next() {
+		var t domain.Transaction
+		if err := rows.Scan(&t.Type, &t.ID, &t.Name, &t.Amount, &t.CategoryId, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func (r *PostgresTransactionRepository) CountByName(ctx context.Context, term string, userId int) (int, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM outcomes WHERE user_id = $1 AND name ILIKE $2 ESCAPE '\') +
+			(SELECT COUNT(*) FROM incomes WHERE user_id = $1 AND name ILIKE $2 ESCAPE '\')
+	`
+
+	var count int
+	err := r.db.QueryRow(ctx, query, userId, containsPattern(term)).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
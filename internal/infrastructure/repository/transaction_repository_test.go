@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgresTransactionRepository_SearchByName_MatchesIncomesAndOutcomes(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewTransactionRepository(mock)
+
+	outcomeDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	incomeDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	rows := pgxmock.NewRows([]string{"type", "id", "name", "amount", "category_id", "created_at"}).
+		AddRow("outcome", 7, "Amazon order", 2599, 2, &outcomeDate).
+		AddRow("income", 3, "Amazon refund", 1500, 0, &incomeDate)
+
+	mock.ExpectQuery(`FROM outcomes (.+) UNION ALL (.+) FROM incomes`).
+		WithArgs(123, "%amazon%", 20, 0).
+		WillReturnRows(rows)
+
+	transactions, err := repo.SearchByName(context.Background(), "amazon", 123, 20, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Transaction{
+		{Type: domain.TransactionTypeOutcome, ID: 7, Name: "Amazon order", Amount: 2599, CategoryId: 2, CreatedAt: &outcomeDate},
+		{Type: domain.TransactionTypeIncome, ID: 3, Name: "Amazon refund", Amount: 1500, CreatedAt: &incomeDate},
+	}, transactions)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresTransactionRepository_SearchByName_EscapesLikeMetacharacters(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewTransactionRepository(mock)
+
+	mock.ExpectQuery(`FROM outcomes`).
+		WithArgs(123, `%50\% off\_sale\\%`, 20, 0).
+		WillReturnRows(pgxmock.NewRows([]string{"type", "id", "name", "amount", "category_id", "created_at"}))
+
+	transactions, err := repo.SearchByName(context.Background(), `50% off_sale\`, 123, 20, 0)
+
+	assert.NoError(t, err)
+	assert.Empty(t, transactions)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresTransactionRepository_CountByName(t *testing.T) {
+	mock, _ := pgxmock.NewPool()
+	defer mock.Close()
+
+	repo := NewTransactionRepository(mock)
+
+	mock.ExpectQuery(`COUNT\(\*\) FROM outcomes (.+) COUNT\(\*\) FROM incomes`).
+		WithArgs(123, "%amazon%").
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+
+	count, err := repo.CountByName(context.Background(), "amazon", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,7 +3,6 @@ package repository
 import (
 	"context"
 
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kerhael/accounting/internal/domain"
 )
 
@@ -16,10 +15,10 @@ type UserRepository interface {
 }
 
 type PostgresUserRepository struct {
-	db *pgxpool.Pool
+	db DB
 }
 
-func NewUserRepository(db *pgxpool.Pool) *PostgresUserRepository {
+func NewUserRepository(db DB) *PostgresUserRepository {
 	return &PostgresUserRepository{db: db}
 }
 
@@ -27,18 +26,18 @@ func (r *PostgresUserRepository) Create(ctx context.Context, u *domain.User) err
 	query := `
 		INSERT INTO users (first_name, last_name, email, password_hash)
 		VALUES ($1, $2, $3, $4)
-		RETURNING id
+		RETURNING id, created_at, require_category
 	`
-	return r.db.QueryRow(ctx, query, u.FirstName, u.LastName, u.Email, u.PasswordHash).Scan(&u.ID)
+	return r.db.QueryRow(ctx, query, u.FirstName, u.LastName, u.Email, u.PasswordHash).Scan(&u.ID, &u.CreatedAt, &u.RequireCategory)
 }
 
 func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user domain.User
 
-	query := `SELECT id, first_name, last_name, email, password_hash, created_at FROM users WHERE email = $1 AND deleted_at IS NULL`
+	query := `SELECT id, first_name, last_name, email, password_hash, COALESCE(default_category_id, 0), require_category, created_at FROM users WHERE email = $1 AND deleted_at IS NULL`
 
 	row := r.db.QueryRow(ctx, query, email)
-	err := row.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	err := row.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.DefaultCategoryId, &user.RequireCategory, &user.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -49,9 +48,9 @@ func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string)
 func (r *PostgresUserRepository) FindById(ctx context.Context, id int) (*domain.User, error) {
 	var u domain.User
 
-	query := `SELECT id, first_name, last_name, email, password_hash, created_at FROM users WHERE id = $1  AND deleted_at IS NULL`
+	query := `SELECT id, first_name, last_name, email, password_hash, COALESCE(default_category_id, 0), require_category, created_at FROM users WHERE id = $1  AND deleted_at IS NULL`
 
-	err := r.db.QueryRow(ctx, query, id).Scan(&u.ID, &u.FirstName, &u.LastName, &u.Email, &u.PasswordHash, &u.CreatedAt)
+	err := r.db.QueryRow(ctx, query, id).Scan(&u.ID, &u.FirstName, &u.LastName, &u.Email, &u.PasswordHash, &u.DefaultCategoryId, &u.RequireCategory, &u.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +72,10 @@ func (r *PostgresUserRepository) DeleteById(ctx context.Context, id int) error {
 func (r *PostgresUserRepository) Update(ctx context.Context, u *domain.User) error {
 	query := `
 		UPDATE users 
-		SET first_name = $2, last_name = $3, password_hash = $4, updated_at = NOW()
+		SET first_name = $2, last_name = $3, password_hash = $4, default_category_id = NULLIF($5, 0), require_category = $6, updated_at = NOW()
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
-	_, err := r.db.Exec(ctx, query, u.ID, u.FirstName, u.LastName, u.PasswordHash)
+	_, err := r.db.Exec(ctx, query, u.ID, u.FirstName, u.LastName, u.PasswordHash, u.DefaultCategoryId, u.RequireCategory)
 	return err
 }
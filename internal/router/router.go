@@ -2,11 +2,55 @@ package router
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/kerhael/accounting/internal/handler"
+	"github.com/kerhael/accounting/internal/handler/utils"
 	"github.com/kerhael/accounting/pkg/middleware"
 )
 
-func RegisterRoutes(mux *http.ServeMux, h *handler.Handlers, rl *middleware.RateLimiter) {
-	RegisterV1Routes(mux, h, rl)
+// allowProbeMethods are the methods tried against an unmatched request to tell
+// a wrong method (405) from an unknown path (404).
+var allowProbeMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// RegisterRoutes registers all API routes under the base path of the handlers,
+// config.DefaultBasePath when unset. authLimiter guards the login, refresh and
+// signup routes, readLimiter the authenticated GET routes and exportLimiter, keyed
+// by user, the export routes. reportTimeout bounds the report routes (sums,
+// series, stats...), crudTimeout every other route.
+func RegisterRoutes(mux *http.ServeMux, h *handler.Handlers, authLimiter *middleware.RateLimiter, readLimiter *middleware.RateLimiter, exportLimiter *middleware.RateLimiter, crudTimeout *middleware.Timeout, reportTimeout *middleware.Timeout) {
+	RegisterV1Routes(mux, h, authLimiter, readLimiter, exportLimiter, crudTimeout, reportTimeout)
+
+	// Catch-all so unmatched routes get a JSON error like the rest of the API
+	mux.HandleFunc("/", unmatched(mux))
+}
+
+// unmatched answers requests no route matched: 405 with an Allow header when the
+// path is served under other methods, 404 otherwise.
+func unmatched(mux *http.ServeMux) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var allowed []string
+		for _, method := range allowProbeMethods {
+			probe := r.Clone(r.Context())
+			probe.Method = method
+			if _, pattern := mux.Handler(probe); pattern != "" && pattern != "/" {
+				allowed = append(allowed, method)
+			}
+		}
+
+		if len(allowed) == 0 {
+			utils.WriteJSONError(w, http.StatusNotFound, "not found")
+			return
+		}
+
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		utils.WriteJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
 }
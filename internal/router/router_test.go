@@ -0,0 +1,248 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/config"
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/handler"
+	"github.com/kerhael/accounting/pkg/middleware"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func newTestMux() *http.ServeMux {
+	return newTestMuxWithLimiters(middleware.NewRateLimiter(1, 5), middleware.NewRateLimiter(10, 30), newTestExportLimiter())
+}
+
+func newTestExportLimiter() *middleware.RateLimiter {
+	return middleware.NewKeyedRateLimiter(10, 30, auth.UserIDKey)
+}
+
+func newTestMuxWithLimiters(authLimiter *middleware.RateLimiter, readLimiter *middleware.RateLimiter, exportLimiter *middleware.RateLimiter) *http.ServeMux {
+	cfg := &config.Config{
+		JWTSecret:  "test-secret",
+		Validation: config.DefaultValidationConfig(),
+		Timezone:   time.UTC,
+	}
+	handlers := handler.NewHandlers(nil, auth.NewJWTService(cfg.JWTSecret), cfg)
+
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, handlers, authLimiter, readLimiter, exportLimiter, middleware.NewTimeout(config.DefaultCRUDTimeout), middleware.NewTimeout(config.DefaultReportsTimeout))
+	return mux
+}
+
+func TestRegisterRoutes_UnknownPathReturnsJSONNotFound(t *testing.T) {
+	mux := newTestMux()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/does-not-exist", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var body domain.ErrorResponse
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "not found", body.Message)
+}
+
+func TestRegisterRoutes_WrongMethodReturnsJSONMethodNotAllowed(t *testing.T) {
+	mux := newTestMux()
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/categories/", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	assert.Equal(t, "GET, HEAD, POST", resp.Header.Get("Allow"))
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var body domain.ErrorResponse
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "method not allowed", body.Message)
+}
+
+func TestRegisterRoutes_KnownPathIsNotCaught(t *testing.T) {
+	mux := newTestMux()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/categories/", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	// Reaches the auth middleware rather than the catch-all
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestRegisterRoutes_AuthRoutesUseStrictLimiter(t *testing.T) {
+	// Very slow refill so buckets don't refill during the test
+	mux := newTestMuxWithLimiters(middleware.NewRateLimiter(rate.Limit(0.001), 2), middleware.NewRateLimiter(rate.Limit(0.001), 10), newTestExportLimiter())
+
+	codes := make([]int, 0, 3)
+	for range 3 {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login/", strings.NewReader("{}"))
+		req.RemoteAddr = "10.0.0.1:1234"
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		codes = append(codes, w.Code)
+	}
+
+	assert.Equal(t, []int{http.StatusBadRequest, http.StatusBadRequest, http.StatusTooManyRequests}, codes)
+}
+
+func TestRegisterRoutes_ReadRoutesUseLooserLimiter(t *testing.T) {
+	mux := newTestMuxWithLimiters(middleware.NewRateLimiter(rate.Limit(0.001), 2), middleware.NewRateLimiter(rate.Limit(0.001), 10), newTestExportLimiter())
+
+	// Exhaust the auth limiter for this client first
+	for range 3 {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login/", strings.NewReader("{}"))
+		req.RemoteAddr = "10.0.0.1:1234"
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	// Reads have their own, larger budget
+	for i := 1; i <= 10; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/categories/", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "read request %d", i)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/categories/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+}
+
+func TestRegisterRoutes_ExportRoutesUsePerUserLimiter(t *testing.T) {
+	// One export per user, with a very slow refill
+	mux := newTestMuxWithLimiters(middleware.NewRateLimiter(1, 5), middleware.NewRateLimiter(10, 30), middleware.NewKeyedRateLimiter(rate.Limit(0.001), 1, auth.UserIDKey))
+	jwtService := auth.NewJWTService("test-secret")
+
+	serve := func(userID int, path string) *httptest.ResponseRecorder {
+		token, err := jwtService.GenerateAccessToken(userID)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.RemoteAddr = "10.0.0.1:1234"
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		return w
+	}
+
+	// An invalid month is rejected by the handler, past the limiter
+	assert.Equal(t, http.StatusBadRequest, serve(1, "/api/v1/reports/monthly.pdf?month=bad").Code)
+
+	// The export budget is shared by the export routes
+	w := serve(1, "/api/v1/outcomes/export.xlsx?month=bad")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+
+	// CRUD routes are unaffected
+	assert.Equal(t, http.StatusBadRequest, serve(1, "/api/v1/categories/abc").Code)
+
+	// Other users have their own budget, even from the same IP
+	assert.Equal(t, http.StatusBadRequest, serve(2, "/api/v1/reports/monthly.pdf?month=bad").Code)
+}
+
+func TestRegisterRoutes_AdminConfigRequiresAdminRole(t *testing.T) {
+	cfg := &config.Config{
+		JWTSecret:    "test-secret",
+		Validation:   config.DefaultValidationConfig(),
+		Timezone:     time.UTC,
+		AdminUserIDs: []int{1},
+	}
+	jwtService := auth.NewJWTService(cfg.JWTSecret)
+	handlers := handler.NewHandlers(nil, jwtService, cfg)
+
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, handlers, middleware.NewRateLimiter(1, 5), middleware.NewRateLimiter(10, 30), newTestExportLimiter(), middleware.NewTimeout(config.DefaultCRUDTimeout), middleware.NewTimeout(config.DefaultReportsTimeout))
+
+	for _, tc := range []struct {
+		userID int
+		code   int
+	}{
+		{userID: 1, code: http.StatusOK},
+		{userID: 2, code: http.StatusForbidden},
+	} {
+		token, err := jwtService.GenerateAccessToken(tc.userID)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/config", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+
+		assert.Equal(t, tc.code, w.Code, "user %d", tc.userID)
+		assert.NotContains(t, w.Body.String(), cfg.JWTSecret)
+	}
+}
+
+func TestRegisterRoutes_LivezIsOpenWhenHealthRequiresSecret(t *testing.T) {
+	cfg := &config.Config{
+		JWTSecret:    "test-secret",
+		Validation:   config.DefaultValidationConfig(),
+		Timezone:     time.UTC,
+		HealthSecret: "health-secret",
+	}
+	handlers := handler.NewHandlers(nil, auth.NewJWTService(cfg.JWTSecret), cfg)
+
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, handlers, middleware.NewRateLimiter(1, 5), middleware.NewRateLimiter(10, 30), newTestExportLimiter(), middleware.NewTimeout(config.DefaultCRUDTimeout), middleware.NewTimeout(config.DefaultReportsTimeout))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/livez", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"server":"ok"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/health", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestRegisterRoutes_CustomBasePath(t *testing.T) {
+	cfg := &config.Config{
+		JWTSecret:  "test-secret",
+		Validation: config.DefaultValidationConfig(),
+		Timezone:   time.UTC,
+		BasePath:   "/accounting/api/v1/",
+	}
+	handlers := handler.NewHandlers(nil, auth.NewJWTService(cfg.JWTSecret), cfg)
+
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, handlers, middleware.NewRateLimiter(1, 5), middleware.NewRateLimiter(10, 30), newTestExportLimiter(), middleware.NewTimeout(config.DefaultCRUDTimeout), middleware.NewTimeout(config.DefaultReportsTimeout))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/accounting/api/v1/livez", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"server":"ok"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/accounting/api/v1/categories/", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// The default prefix is no longer served
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/livez", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
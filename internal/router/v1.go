@@ -2,41 +2,96 @@ package router
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/kerhael/accounting/internal/auth"
+	"github.com/kerhael/accounting/internal/config"
 	"github.com/kerhael/accounting/internal/handler"
 	"github.com/kerhael/accounting/pkg/middleware"
 )
 
-func RegisterV1Routes(mux *http.ServeMux, h *handler.Handlers, rl *middleware.RateLimiter) {
-	mux.HandleFunc("GET    /api/v1/health", h.V1.Health.Check)
-
-	mux.Handle("GET    /api/v1/categories/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.GetAllCategories)))
-	mux.Handle("POST   /api/v1/categories/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.PostCategory)))
-	mux.Handle("GET    /api/v1/categories/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.GetCategoryById)))
-	mux.Handle("DELETE /api/v1/categories/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.DeleteCategoryById)))
-
-	mux.Handle("POST   /api/v1/outcomes/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.PostOutcome)))
-	mux.Handle("GET    /api/v1/outcomes/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetAllOutcomes)))
-	mux.Handle("GET    /api/v1/outcomes/sums-by-category", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesSum)))
-	mux.Handle("GET    /api/v1/outcomes/total", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesTotal)))
-	mux.Handle("GET    /api/v1/outcomes/series-by-category", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesSeries)))
-	mux.Handle("GET    /api/v1/outcomes/series-total", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesTotalSeries)))
-	mux.Handle("GET    /api/v1/outcomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomeById)))
-	mux.Handle("PATCH  /api/v1/outcomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.PatchOutcomeById)))
-	mux.Handle("DELETE /api/v1/outcomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.DeleteOutcomeById)))
-
-	mux.Handle("POST   /api/v1/incomes/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.PostIncome)))
-	mux.Handle("GET    /api/v1/incomes/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.GetAllIncomes)))
-	mux.Handle("GET    /api/v1/incomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.GetIncomeById)))
-	mux.Handle("PATCH  /api/v1/incomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.PatchIncomeById)))
-	mux.Handle("DELETE /api/v1/incomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.DeleteIncomeById)))
-
-	mux.Handle("POST   /api/v1/users/", rl.RateLimitMiddleware(http.HandlerFunc(h.V1.Users.PostUser)))
-	mux.Handle("GET    /api/v1/users/me", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Users.GetMe)))
-	mux.Handle("PATCH  /api/v1/users/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Users.PatchUserById)))
-	mux.Handle("DELETE  /api/v1/users/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Users.DeleteUserById)))
-
-	mux.Handle("POST   /api/v1/login/", rl.RateLimitMiddleware(http.HandlerFunc(h.V1.Auth.Login)))
-	mux.Handle("POST   /api/v1/refresh/", rl.RateLimitMiddleware(http.HandlerFunc(h.V1.Auth.RefreshToken)))
+func RegisterV1Routes(mux *http.ServeMux, h *handler.Handlers, authLimiter *middleware.RateLimiter, readLimiter *middleware.RateLimiter, exportLimiter *middleware.RateLimiter, crudTimeout *middleware.Timeout, reportTimeout *middleware.Timeout) {
+	basePath := h.BasePath
+	if basePath == "" {
+		basePath = config.DefaultBasePath
+	}
+
+	// Patterns are "METHOD path", the path being relative to the base path
+	handle := func(pattern string, handler http.Handler) {
+		method, path, _ := strings.Cut(pattern, " ")
+		mux.Handle(method+" "+basePath+strings.TrimSpace(path), handler)
+	}
+
+	// Route groups, each with its own request deadline
+	crud := func(pattern string, handler http.Handler) {
+		handle(pattern, crudTimeout.TimeoutMiddleware(handler))
+	}
+	report := func(pattern string, handler http.Handler) {
+		handle(pattern, reportTimeout.TimeoutMiddleware(handler))
+	}
+
+	handle("GET    livez", http.HandlerFunc(h.V1.Health.Live))
+	handle("GET    health", auth.SharedSecretMiddleware(auth.HealthSecretHeader, h.HealthSecret)(http.HandlerFunc(h.V1.Health.Check)))
+	handle("GET    time", http.HandlerFunc(h.V1.Time.GetTime))
+
+	crud("GET    categories/", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.GetAllCategories))))
+	crud("POST   categories/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.PostCategory)))
+	crud("POST   categories/ensure-defaults", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.EnsureDefaultCategories)))
+	report("GET    categories/archive-suggestions", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.GetArchiveSuggestions))))
+	crud("GET    categories/{id}", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.GetCategoryById))))
+	crud("PATCH  categories/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.PatchCategoryById)))
+	crud("POST   categories/{id}/clone", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.CloneCategoryById)))
+	crud("DELETE categories/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Category.DeleteCategoryById)))
+
+	crud("POST   outcomes/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.PostOutcome)))
+	crud("GET    outcomes/", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetAllOutcomes))))
+	report("GET    outcomes/sums-by-category", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesSum))))
+	report("GET    outcomes/avg-by-category", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesAverageByCategory))))
+	report("GET    outcomes/outliers", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesOutliers))))
+	report("GET    outcomes/by-hour", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesByHour))))
+	report("GET    outcomes/frequent", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesFrequentNames))))
+	report("GET    outcomes/histogram", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesHistogram))))
+	report("GET    outcomes/total", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesTotal))))
+	report("GET    outcomes/series-by-category", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesSeries))))
+	report("GET    outcomes/series-total", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesTotalSeries))))
+	report("GET    outcomes/monthly-counts", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesMonthlyCounts))))
+	report("GET    outcomes/rolling", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesRolling))))
+	report("GET    outcomes/forecast", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesForecast))))
+	report("GET    outcomes/streaks", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesStreaks))))
+	report("GET    outcomes/grouped", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesGrouped))))
+	report("GET    outcomes/projection", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesProjection))))
+	report("GET    outcomes/new-categories", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesNewCategories))))
+	report("GET    outcomes/year-over-year", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesYearOverYear))))
+	report("GET    outcomes/export.xlsx", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(exportLimiter.RateLimitMiddleware(http.HandlerFunc(h.V1.Outcomes.ExportOutcomesXLSX)))))
+	report("GET    outcomes/date-bounds", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomesDateBounds))))
+	crud("GET    outcomes/{id}", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.GetOutcomeById))))
+	crud("PATCH  outcomes/bulk", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.BulkPatchOutcomes)))
+	crud("PATCH  outcomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.PatchOutcomeById)))
+	crud("DELETE outcomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Outcomes.DeleteOutcomeById)))
+
+	crud("POST   incomes/", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.PostIncome)))
+	crud("GET    incomes/", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.GetAllIncomes))))
+	report("GET    incomes/largest", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.GetLargestIncomes))))
+	report("GET    incomes/stats", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.GetIncomesStats))))
+	report("GET    incomes/date-bounds", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.GetIncomesDateBounds))))
+	crud("GET    incomes/{id}", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.GetIncomeById))))
+	crud("PATCH  incomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.PatchIncomeById)))
+	crud("DELETE incomes/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Incomes.DeleteIncomeById)))
+
+	crud("POST   users/", authLimiter.RateLimitMiddleware(http.HandlerFunc(h.V1.Users.PostUser)))
+	crud("GET    users/me", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Users.GetMe))))
+	crud("GET    users/me/onboarding", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Onboarding.GetOnboardingStatus))))
+	crud("PATCH  users/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Users.PatchUserById)))
+	crud("DELETE  users/{id}", auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Users.DeleteUserById)))
+
+	report("GET    transactions/search", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Transactions.SearchTransactions))))
+	report("GET    balance/pay-period", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Reports.GetPayPeriodBalance))))
+	report("GET    reports/spend-vs-income", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Reports.GetSpendVsIncome))))
+	report("GET    reports/savings-rate", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(http.HandlerFunc(h.V1.Reports.GetSavingsRate))))
+	report("GET    reports/monthly.pdf", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(exportLimiter.RateLimitMiddleware(http.HandlerFunc(h.V1.Reports.ExportMonthlyStatement)))))
+
+	crud("GET    admin/config", readLimiter.RateLimitMiddleware(auth.AuthMiddleware(h.JWT)(auth.AdminMiddleware(h.AdminUserIDs)(http.HandlerFunc(h.V1.Admin.GetConfig)))))
+
+	crud("POST   login/", authLimiter.RateLimitMiddleware(http.HandlerFunc(h.V1.Auth.Login)))
+	crud("POST   refresh/", authLimiter.RateLimitMiddleware(http.HandlerFunc(h.V1.Auth.RefreshToken)))
 }
@@ -4,42 +4,66 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/kerhael/accounting/internal/config"
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/kerhael/accounting/internal/infrastructure/repository"
 )
 
 type CategoryServiceInterface interface {
-	Create(ctx context.Context, label string, userId int) (*domain.Category, error)
+	Create(ctx context.Context, label string, color string, userId int) (*domain.Category, error)
 	GetAll(ctx context.Context, userId int) ([]domain.Category, error)
+	GetAllBySpend(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.Category, error)
+	GetArchiveSuggestions(ctx context.Context, staleMonths int, userId int) ([]domain.CategoryUsage, error)
 	GetById(ctx context.Context, id int, userId int) (*domain.Category, error)
+	PatchById(ctx context.Context, id int, label string, color *string, userId int) (*domain.Category, error)
+	Clone(ctx context.Context, id int, label string, userId int) (*domain.Category, error)
+	EnsureDefaults(ctx context.Context, userId int) ([]domain.Category, bool, error)
 	DeleteById(ctx context.Context, id int, userId int) error
 }
 
 type CategoryService struct {
-	repo repository.CategoryRepository
+	repo          repository.CategoryRepository
+	validation    config.ValidationConfig
+	defaultLabels []string
 }
 
-func NewCategoryService(repo repository.CategoryRepository) *CategoryService {
-	return &CategoryService{repo: repo}
+// NewCategoryService builds the category service, defaultLabels being the starter
+// categories created by EnsureDefaults.
+func NewCategoryService(repo repository.CategoryRepository, validation config.ValidationConfig, defaultLabels []string) *CategoryService {
+	return &CategoryService{repo: repo, validation: validation, defaultLabels: defaultLabels}
 }
 
-func (s *CategoryService) Create(ctx context.Context, label string, userId int) (*domain.Category, error) {
+func (s *CategoryService) Create(ctx context.Context, label string, color string, userId int) (*domain.Category, error) {
 	label = strings.TrimSpace(label)
+	if s.validation.NormalizeCategoryLabels {
+		label = normalizeLabel(label)
+	}
 	if label == "" {
 		return nil, &domain.InvalidEntityError{
 			UnderlyingCause: errors.New("label is required"),
 		}
 	}
+	if err := checkMaxLength("label", label, s.validation.MaxNameLength); err != nil {
+		return nil, err
+	}
+	if err := checkColor(color); err != nil {
+		return nil, err
+	}
+	if err := s.checkUniqueLabel(ctx, label, 0, userId); err != nil {
+		return nil, err
+	}
 
 	category := &domain.Category{
 		Label:  label,
+		Color:  color,
 		UserId: userId,
 	}
 
 	if err := s.repo.Create(ctx, category); err != nil {
-		return nil, err
+		return nil, checkLabelConflict(err)
 	}
 
 	return category, nil
@@ -54,6 +78,43 @@ func (s *CategoryService) GetAll(ctx context.Context, userId int) ([]domain.Cate
 	return categories, nil
 }
 
+func (s *CategoryService) GetAllBySpend(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.Category, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	return s.repo.FindAllBySpend(ctx, from, to, userId)
+}
+
+// GetArchiveSuggestions returns the categories worth archiving: those never used
+// by an outcome or whose most recent outcome is older than staleMonths.
+func (s *CategoryService) GetArchiveSuggestions(ctx context.Context, staleMonths int, userId int) ([]domain.CategoryUsage, error) {
+	if staleMonths <= 0 {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("staleMonths must be positive"),
+		}
+	}
+
+	usages, err := s.repo.FindUsage(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	staleBefore := time.Now().AddDate(0, -staleMonths, 0)
+	suggestions := []domain.CategoryUsage{}
+	for _, u := range usages {
+		if u.LastUsedAt == nil || u.LastUsedAt.Before(staleBefore) {
+			suggestions = append(suggestions, u)
+		}
+	}
+
+	return suggestions, nil
+}
+
 func (s *CategoryService) GetById(ctx context.Context, id int, userId int) (*domain.Category, error) {
 	if id <= 0 {
 		return nil, &domain.InvalidEntityError{
@@ -74,6 +135,102 @@ func (s *CategoryService) GetById(ctx context.Context, id int, userId int) (*dom
 	return category, nil
 }
 
+// PatchById updates the non-empty label and the non-nil color of a category, an
+// empty color clearing it.
+func (s *CategoryService) PatchById(ctx context.Context, id int, label string, color *string, userId int) (*domain.Category, error) {
+	category, err := s.GetById(ctx, id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	label = strings.TrimSpace(label)
+	if label != "" {
+		if s.validation.NormalizeCategoryLabels {
+			label = normalizeLabel(label)
+		}
+		if err := checkMaxLength("label", label, s.validation.MaxNameLength); err != nil {
+			return nil, err
+		}
+		if err := s.checkUniqueLabel(ctx, label, category.ID, userId); err != nil {
+			return nil, err
+		}
+		category.Label = label
+	}
+
+	if color != nil {
+		if err := checkColor(*color); err != nil {
+			return nil, err
+		}
+		category.Color = *color
+	}
+
+	if err := s.repo.Update(ctx, category); err != nil {
+		return nil, checkLabelConflict(err)
+	}
+
+	return category, nil
+}
+
+// Clone creates a new category with the settings of an existing one but none of
+// its outcomes. An empty label defaults to the source label suffixed with " (copy)".
+func (s *CategoryService) Clone(ctx context.Context, id int, label string, userId int) (*domain.Category, error) {
+	source, err := s.GetById(ctx, id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(label) == "" {
+		label = source.Label + " (copy)"
+	}
+
+	return s.Create(ctx, label, source.Color, userId)
+}
+
+// EnsureDefaults creates the starter categories of a user who has none yet, and
+// returns the user's categories. It is idempotent: once the user has categories,
+// whether starter ones or not, nothing is created and false is returned. The
+// starters are created all at once or not at all, even under concurrent calls.
+func (s *CategoryService) EnsureDefaults(ctx context.Context, userId int) ([]domain.Category, bool, error) {
+	existing, err := s.repo.FindAll(ctx, userId)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(existing) > 0 || len(s.defaultLabels) == 0 {
+		return existing, false, nil
+	}
+
+	categories := make([]domain.Category, 0, len(s.defaultLabels))
+	for _, label := range s.defaultLabels {
+		label = strings.TrimSpace(label)
+		if s.validation.NormalizeCategoryLabels {
+			label = normalizeLabel(label)
+		}
+		if err := checkMaxLength("label", label, s.validation.MaxNameLength); err != nil {
+			return nil, false, err
+		}
+		// Starters have no ID yet, none is excluded
+		if err := checkDuplicateLabel(label, categories, -1); err != nil {
+			return nil, false, err
+		}
+		categories = append(categories, domain.Category{Label: label, UserId: userId})
+	}
+
+	created, err := s.repo.CreateAllIfNone(ctx, categories, userId)
+	if err != nil {
+		return nil, false, err
+	}
+	if !created {
+		// Another request created categories in the meantime
+		existing, err := s.repo.FindAll(ctx, userId)
+		if err != nil {
+			return nil, false, err
+		}
+		return existing, false, nil
+	}
+
+	return categories, true, nil
+}
+
 func (s *CategoryService) DeleteById(ctx context.Context, id int, userId int) error {
 	if id <= 0 {
 		return &domain.InvalidEntityError{
@@ -83,3 +240,11 @@ func (s *CategoryService) DeleteById(ctx context.Context, id int, userId int) er
 
 	return s.repo.DeleteById(ctx, id, userId)
 }
+
+func (s *CategoryService) checkUniqueLabel(ctx context.Context, label string, excludeId int, userId int) error {
+	categories, err := s.repo.FindAll(ctx, userId)
+	if err != nil {
+		return err
+	}
+	return checkDuplicateLabel(label, categories, excludeId)
+}
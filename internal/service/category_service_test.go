@@ -3,9 +3,13 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kerhael/accounting/internal/config"
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/kerhael/accounting/internal/infrastructure/repository/mocks"
 	"github.com/stretchr/testify/assert"
@@ -14,18 +18,19 @@ import (
 
 func TestCreateCategory_Success(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	label := "Food"
 	userId := 123
 
+	mockRepo.On("FindAll", ctx, mock.Anything).Return([]domain.Category{}, nil)
 	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Category")).Return(nil).Run(func(args mock.Arguments) {
 		arg := args.Get(1).(*domain.Category)
 		arg.ID = 1
 	})
 
-	category, err := service.Create(ctx, label, userId)
+	category, err := service.Create(ctx, label, "", userId)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, category)
@@ -37,10 +42,10 @@ func TestCreateCategory_Success(t *testing.T) {
 
 func TestCreateCategory_InvalidLabel(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
-	category, err := service.Create(ctx, "  ", 123)
+	category, err := service.Create(ctx, "  ", "", 123)
 
 	assert.Nil(t, category)
 	assert.Error(t, err)
@@ -52,15 +57,16 @@ func TestCreateCategory_InvalidLabel(t *testing.T) {
 
 func TestCreateCategory_RepoError(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	label := "Travel"
 	userId := 123
 
+	mockRepo.On("FindAll", ctx, mock.Anything).Return([]domain.Category{}, nil)
 	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Category")).Return(errors.New("db failure"))
 
-	category, err := service.Create(ctx, label, userId)
+	category, err := service.Create(ctx, label, "", userId)
 
 	assert.Nil(t, category)
 	assert.Error(t, err)
@@ -72,7 +78,7 @@ func TestCreateCategory_RepoError(t *testing.T) {
 
 func TestDeleteById_Success(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	id := 1
@@ -89,7 +95,7 @@ func TestDeleteById_Success(t *testing.T) {
 
 func TestDeleteById_InvalidId(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	invalidId := -1
@@ -106,7 +112,7 @@ func TestDeleteById_InvalidId(t *testing.T) {
 
 func TestDeleteById_RepositoryError(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	id := 1
@@ -125,7 +131,7 @@ func TestDeleteById_RepositoryError(t *testing.T) {
 
 func TestGetCategoryById_Success(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	category := &domain.Category{
@@ -147,7 +153,7 @@ func TestGetCategoryById_Success(t *testing.T) {
 
 func TestGetCategoryById_InvalidId(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 
@@ -163,7 +169,7 @@ func TestGetCategoryById_InvalidId(t *testing.T) {
 
 func TestGetCategoryById_NotFound(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	id := 2
@@ -183,7 +189,7 @@ func TestGetCategoryById_NotFound(t *testing.T) {
 
 func TestGetAllCategories_Success(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	userId := 123
@@ -213,7 +219,7 @@ func TestGetAllCategories_Success(t *testing.T) {
 
 func TestGetAllCategories_RepositoryError(t *testing.T) {
 	mockRepo := new(mocks.CategoryRepository)
-	service := NewCategoryService(mockRepo)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
 
 	ctx := context.Background()
 	userId := 123
@@ -228,3 +234,527 @@ func TestGetAllCategories_RepositoryError(t *testing.T) {
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestGetAllCategoriesBySpend_Success(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+
+	ctx := context.Background()
+	userId := 123
+	expected := []domain.Category{
+		{ID: 2, UserId: userId, Label: "Rent"},
+		{ID: 1, UserId: userId, Label: "Books"},
+	}
+
+	mockRepo.On("FindAllBySpend", ctx, (*time.Time)(nil), (*time.Time)(nil), userId).Return(expected, nil)
+
+	categories, err := service.GetAllBySpend(ctx, nil, nil, userId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, categories)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetAllCategoriesBySpend_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	categories, err := service.GetAllBySpend(context.Background(), &from, &to, 123)
+
+	assert.Nil(t, categories)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+	mockRepo.AssertNotCalled(t, "FindAllBySpend")
+}
+
+func TestGetAllCategoriesBySpend_ClampFutureTo(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	validation := config.DefaultValidationConfig()
+	validation.ClampFutureTo = true
+	service := NewCategoryService(mockRepo, validation, nil)
+	ctx := context.Background()
+
+	from := time.Now().AddDate(0, -1, 0)
+	future := time.Now().AddDate(0, 0, 10)
+	clamped := mock.MatchedBy(func(to *time.Time) bool {
+		return to != nil && !to.After(time.Now())
+	})
+	mockRepo.On("FindAllBySpend", ctx, &from, clamped, 123).Return([]domain.Category{}, nil)
+
+	_, err := service.GetAllBySpend(ctx, &from, &future, 123)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetArchiveSuggestions(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+
+	ctx := context.Background()
+	userId := 123
+	stale := time.Now().AddDate(0, -7, 0)
+	active := time.Now().AddDate(0, -1, 0)
+	unused := domain.CategoryUsage{Category: domain.Category{ID: 3, UserId: userId, Label: "Books"}}
+	staleUsage := domain.CategoryUsage{Category: domain.Category{ID: 2, UserId: userId, Label: "Gym"}, LastUsedAt: &stale, OutcomeCount: 12}
+	activeUsage := domain.CategoryUsage{Category: domain.Category{ID: 1, UserId: userId, Label: "Food"}, LastUsedAt: &active, OutcomeCount: 80}
+
+	mockRepo.On("FindUsage", ctx, userId).Return([]domain.CategoryUsage{unused, staleUsage, activeUsage}, nil)
+
+	suggestions, err := service.GetArchiveSuggestions(ctx, 6, userId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CategoryUsage{unused, staleUsage}, suggestions)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetArchiveSuggestions_InvalidStaleMonths(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+
+	suggestions, err := service.GetArchiveSuggestions(context.Background(), 0, 123)
+
+	assert.Nil(t, suggestions)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "FindUsage")
+}
+
+func TestCreateCategory_LabelAtMaxLength(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.ValidationConfig{MaxNameLength: 10}, nil)
+	ctx := context.Background()
+
+	label := strings.Repeat("a", 10)
+	mockRepo.On("FindAll", ctx, mock.Anything).Return([]domain.Category{}, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Category")).Return(nil)
+
+	category, err := service.Create(ctx, "  "+label+"  ", "", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, label, category.Label)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateCategory_LabelOverMaxLength(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.ValidationConfig{MaxNameLength: 10}, nil)
+	ctx := context.Background()
+
+	category, err := service.Create(ctx, strings.Repeat("a", 11), "", 123)
+
+	assert.Nil(t, category)
+	var invalidErr *domain.InvalidEntityError
+	assert.True(t, errors.As(err, &invalidErr))
+	assert.Equal(t, "label must be at most 10 characters", invalidErr.UnderlyingCause.Error())
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestCreateCategory_NormalizesLabelWhenEnabled(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	validation := config.DefaultValidationConfig()
+	validation.NormalizeCategoryLabels = true
+	service := NewCategoryService(mockRepo, validation, nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindAll", ctx, mock.Anything).Return([]domain.Category{}, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(c *domain.Category) bool {
+		return c.Label == "Food Court"
+	})).Return(nil)
+
+	category, err := service.Create(ctx, "  food   court ", "", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Food Court", category.Label)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateCategory_KeepsLabelWhenNormalizationDisabled(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindAll", ctx, mock.Anything).Return([]domain.Category{}, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Category")).Return(nil)
+
+	category, err := service.Create(ctx, "  food   court ", "", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "food   court", category.Label)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateCategory_ValidColor(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindAll", ctx, mock.Anything).Return([]domain.Category{}, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(c *domain.Category) bool {
+		return c.Color == "#1a2B3c"
+	})).Return(nil)
+
+	category, err := service.Create(ctx, "Food", "#1a2B3c", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "#1a2B3c", category.Color)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateCategory_InvalidColor(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	for _, color := range []string{"red", "#12345", "#1234567", "123456", "#GGGGGG"} {
+		category, err := service.Create(ctx, "Food", color, 123)
+
+		assert.Nil(t, category, color)
+		var invalidErr *domain.InvalidEntityError
+		assert.True(t, errors.As(err, &invalidErr), color)
+		assert.Equal(t, "color must be a #RRGGBB hex string", invalidErr.UnderlyingCause.Error())
+	}
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestPatchCategoryById_Color(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Category{ID: 1, UserId: 123, Label: "Food"}, nil)
+	mockRepo.On("Update", ctx, &domain.Category{ID: 1, UserId: 123, Label: "Food", Color: "#00FF00"}).Return(nil)
+
+	color := "#00FF00"
+	category, err := service.PatchById(ctx, 1, "", &color, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Food", category.Label)
+	assert.Equal(t, "#00FF00", category.Color)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPatchCategoryById_ClearColor(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Category{ID: 1, UserId: 123, Label: "Food", Color: "#00FF00"}, nil)
+	mockRepo.On("Update", ctx, &domain.Category{ID: 1, UserId: 123, Label: "Food", Color: ""}).Return(nil)
+
+	color := ""
+	category, err := service.PatchById(ctx, 1, "", &color, 123)
+
+	assert.NoError(t, err)
+	assert.Empty(t, category.Color)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPatchCategoryById_KeepColor(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Category{ID: 1, UserId: 123, Label: "Food", Color: "#00FF00"}, nil)
+	mockRepo.On("FindAll", ctx, 123).Return([]domain.Category{{ID: 1, UserId: 123, Label: "Food"}}, nil)
+	mockRepo.On("Update", ctx, &domain.Category{ID: 1, UserId: 123, Label: "Meals", Color: "#00FF00"}).Return(nil)
+
+	category, err := service.PatchById(ctx, 1, "Meals", nil, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "#00FF00", category.Color)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPatchCategoryById_InvalidColor(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Category{ID: 1, UserId: 123, Label: "Food"}, nil)
+
+	color := "green"
+	category, err := service.PatchById(ctx, 1, "", &color, 123)
+
+	assert.Nil(t, category)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestPatchCategoryById_NotFound(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 9, 123).Return(nil, pgx.ErrNoRows)
+
+	category, err := service.PatchById(ctx, 9, "Food", nil, 123)
+
+	assert.Nil(t, category)
+	assert.IsType(t, &domain.EntityNotFoundError{}, err)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCreateCategory_DuplicateLabelWithCollapsedWhitespace(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindAll", ctx, 123).Return([]domain.Category{
+		{ID: 1, Label: "Food  Court", UserId: 123},
+	}, nil)
+
+	category, err := service.Create(ctx, " Food Court ", "", 123)
+
+	assert.Nil(t, category)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.EqualError(t, err, "invalid entity data: category already exists")
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestPatchCategoryById_DuplicateLabelWithCollapsedWhitespace(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 2, 123).Return(&domain.Category{ID: 2, Label: "Books", UserId: 123}, nil)
+	mockRepo.On("FindAll", ctx, 123).Return([]domain.Category{
+		{ID: 1, Label: "Food Court", UserId: 123},
+		{ID: 2, Label: "Books", UserId: 123},
+	}, nil)
+
+	category, err := service.PatchById(ctx, 2, "Food   Court", nil, 123)
+
+	assert.Nil(t, category)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestPatchCategoryById_SameLabelIsNotDuplicate(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Category{ID: 1, Label: "Food  Court", UserId: 123}, nil)
+	mockRepo.On("FindAll", ctx, 123).Return([]domain.Category{
+		{ID: 1, Label: "Food  Court", UserId: 123},
+	}, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.Category")).Return(nil)
+
+	category, err := service.PatchById(ctx, 1, "Food Court", nil, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Food Court", category.Label)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCloneCategory_CopiesColorWithDefaultLabel(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+
+	ctx := context.Background()
+	source := &domain.Category{ID: 1, Label: "Food", Color: "#FF8800", UserId: 123}
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(source, nil)
+	mockRepo.On("FindAll", ctx, 123).Return([]domain.Category{*source}, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(c *domain.Category) bool {
+		return c.Label == "Food (copy)" && c.Color == "#FF8800" && c.UserId == 123
+	})).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*domain.Category).ID = 2
+	})
+
+	clone, err := service.Clone(ctx, 1, "", 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, clone.ID)
+	assert.Equal(t, "Food (copy)", clone.Label)
+	assert.Equal(t, "#FF8800", clone.Color)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCloneCategory_DuplicateLabel(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+
+	ctx := context.Background()
+	source := &domain.Category{ID: 1, Label: "Food", Color: "#FF8800", UserId: 123}
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(source, nil)
+	mockRepo.On("FindAll", ctx, 123).Return([]domain.Category{*source, {ID: 2, Label: "Groceries", UserId: 123}}, nil)
+
+	clone, err := service.Clone(ctx, 1, "Groceries", 123)
+
+	assert.Nil(t, clone)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCloneCategory_LabelTakenConcurrently(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+
+	ctx := context.Background()
+	source := &domain.Category{ID: 1, Label: "Food", UserId: 123}
+
+	// Another request stores the same label between the check and the insert
+	mockRepo.On("FindById", ctx, 1, 123).Return(source, nil)
+	mockRepo.On("FindAll", ctx, 123).Return([]domain.Category{*source}, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Category")).Return(&pgconn.PgError{Code: "23505"})
+
+	clone, err := service.Clone(ctx, 1, "", 123)
+
+	assert.Nil(t, clone)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.EqualError(t, err, "invalid entity data: category already exists")
+}
+
+func TestCloneCategory_SourceNotFound(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), nil)
+
+	ctx := context.Background()
+	mockRepo.On("FindById", ctx, 9, 123).Return(nil, pgx.ErrNoRows)
+
+	clone, err := service.Clone(ctx, 9, "", 123)
+
+	assert.Nil(t, clone)
+	assert.IsType(t, &domain.EntityNotFoundError{}, err)
+}
+
+func TestEnsureDefaults_CreatesOnceThenSkips(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), []string{"Groceries", "Housing"})
+
+	ctx := context.Background()
+	starters := []domain.Category{
+		{ID: 1, Label: "Groceries", UserId: 123},
+		{ID: 2, Label: "Housing", UserId: 123},
+	}
+
+	// The first call lists no category and creates the starters, the second one
+	// lists the starters created by the first
+	mockRepo.On("FindAll", ctx, 123).Return(nil, nil).Once()
+	mockRepo.On("FindAll", ctx, 123).Return(starters, nil).Once()
+	mockRepo.On("CreateAllIfNone", ctx, []domain.Category{
+		{Label: "Groceries", UserId: 123},
+		{Label: "Housing", UserId: 123},
+	}, 123).Return(true, nil).Once()
+
+	categories, created, err := service.EnsureDefaults(ctx, 123)
+
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, []string{"Groceries", "Housing"}, []string{categories[0].Label, categories[1].Label})
+
+	categories, created, err = service.EnsureDefaults(ctx, 123)
+
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, starters, categories)
+
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNumberOfCalls(t, "CreateAllIfNone", 1)
+}
+
+func TestEnsureDefaults_SameStartersForEveryUser(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), []string{"Groceries", "Housing"})
+
+	ctx := context.Background()
+
+	// Labels are unique per user, so a second user gets the same starters
+	for _, userId := range []int{123, 456} {
+		mockRepo.On("FindAll", ctx, userId).Return(nil, nil).Once()
+		mockRepo.On("CreateAllIfNone", ctx, []domain.Category{
+			{Label: "Groceries", UserId: userId},
+			{Label: "Housing", UserId: userId},
+		}, userId).Return(true, nil).Once()
+	}
+
+	for _, userId := range []int{123, 456} {
+		categories, created, err := service.EnsureDefaults(ctx, userId)
+
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, []domain.Category{
+			{Label: "Groceries", UserId: userId},
+			{Label: "Housing", UserId: userId},
+		}, categories)
+	}
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEnsureDefaults_SkipsWhenUserHasCategories(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), []string{"Groceries", "Housing"})
+
+	ctx := context.Background()
+	existing := []domain.Category{{ID: 7, Label: "Food", UserId: 123}}
+	mockRepo.On("FindAll", ctx, 123).Return(existing, nil)
+
+	categories, created, err := service.EnsureDefaults(ctx, 123)
+
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, existing, categories)
+	mockRepo.AssertNotCalled(t, "CreateAllIfNone", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEnsureDefaults_ConcurrentCallCreatedFirst(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), []string{"Groceries", "Housing"})
+
+	ctx := context.Background()
+	starters := []domain.Category{
+		{ID: 1, Label: "Groceries", UserId: 123},
+		{ID: 2, Label: "Housing", UserId: 123},
+	}
+
+	// No category when listed, but another request creates the starters before
+	// this one gets the lock
+	mockRepo.On("FindAll", ctx, 123).Return(nil, nil).Once()
+	mockRepo.On("CreateAllIfNone", ctx, mock.Anything, 123).Return(false, nil)
+	mockRepo.On("FindAll", ctx, 123).Return(starters, nil).Once()
+
+	categories, created, err := service.EnsureDefaults(ctx, 123)
+
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, starters, categories)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEnsureDefaults_CreateFails(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), []string{"Groceries", "Housing"})
+
+	ctx := context.Background()
+	mockRepo.On("FindAll", ctx, 123).Return(nil, nil)
+	mockRepo.On("CreateAllIfNone", ctx, mock.Anything, 123).Return(false, errors.New("insert failed"))
+
+	categories, created, err := service.EnsureDefaults(ctx, 123)
+
+	assert.EqualError(t, err, "insert failed")
+	assert.False(t, created)
+	assert.Nil(t, categories)
+}
+
+func TestEnsureDefaults_DuplicateStarters(t *testing.T) {
+	mockRepo := new(mocks.CategoryRepository)
+	service := NewCategoryService(mockRepo, config.DefaultValidationConfig(), []string{"Groceries", "Groceries "})
+
+	ctx := context.Background()
+	mockRepo.On("FindAll", ctx, 123).Return(nil, nil)
+
+	categories, created, err := service.EnsureDefaults(ctx, 123)
+
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.False(t, created)
+	assert.Nil(t, categories)
+	mockRepo.AssertNotCalled(t, "CreateAllIfNone", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/kerhael/accounting/internal/config"
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/kerhael/accounting/internal/infrastructure/repository"
 )
@@ -17,14 +18,18 @@ type IncomeServiceInterface interface {
 	GetById(ctx context.Context, id int, userId int) (*domain.Income, error)
 	PatchById(ctx context.Context, id int, name string, amount int, createdAt *time.Time, userId int) (*domain.Income, error)
 	DeleteById(ctx context.Context, id int, userId int) error
+	GetLargest(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.Income, error)
+	GetStats(ctx context.Context, from *time.Time, to *time.Time, userId int) (*domain.AmountStats, error)
+	GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error)
 }
 
 type IncomeService struct {
-	repo repository.IncomeRepository
+	repo       repository.IncomeRepository
+	validation config.ValidationConfig
 }
 
-func NewIncomeService(repo repository.IncomeRepository) *IncomeService {
-	return &IncomeService{repo: repo}
+func NewIncomeService(repo repository.IncomeRepository, validation config.ValidationConfig) *IncomeService {
+	return &IncomeService{repo: repo, validation: validation}
 }
 
 func (s *IncomeService) Create(ctx context.Context, name string, amount int, createdAt *time.Time, userId int) (*domain.Income, error) {
@@ -34,6 +39,12 @@ func (s *IncomeService) Create(ctx context.Context, name string, amount int, cre
 			UnderlyingCause: errors.New("name cannot be empty"),
 		}
 	}
+	if err := checkMaxLength("name", name, s.validation.MaxNameLength); err != nil {
+		return nil, err
+	}
+	if err := checkNotNumeric("name", name, s.validation.RejectNumericNames); err != nil {
+		return nil, err
+	}
 
 	if amount <= 0 {
 		return nil, &domain.InvalidEntityError{
@@ -68,6 +79,8 @@ func (s *IncomeService) GetAll(ctx context.Context, from *time.Time, to *time.Ti
 		}
 	}
 
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
 	incomes, err := s.repo.FindAll(ctx, from, to, userId, limit, offset)
 	if err != nil {
 		return nil, 0, err
@@ -102,6 +115,12 @@ func (s *IncomeService) GetById(ctx context.Context, id int, userId int) (*domai
 }
 
 func (s *IncomeService) PatchById(ctx context.Context, id int, name string, amount int, createdAt *time.Time, userId int) (*domain.Income, error) {
+	if amount < 0 {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("amount must be positive"),
+		}
+	}
+
 	income, err := s.repo.FindById(ctx, id, userId)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -111,6 +130,12 @@ func (s *IncomeService) PatchById(ctx context.Context, id int, name string, amou
 		}
 		return nil, err
 	}
+	if err := checkNotFrozen(income.CreatedAt, s.validation.FreezeDate); err != nil {
+		return nil, err
+	}
+	if err := checkNotFrozen(createdAt, s.validation.FreezeDate); err != nil {
+		return nil, err
+	}
 
 	i := &domain.Income{
 		ID:     income.ID,
@@ -118,6 +143,12 @@ func (s *IncomeService) PatchById(ctx context.Context, id int, name string, amou
 	}
 
 	if name != "" {
+		if err := checkMaxLength("name", name, s.validation.MaxNameLength); err != nil {
+			return nil, err
+		}
+		if err := checkNotNumeric("name", name, s.validation.RejectNumericNames); err != nil {
+			return nil, err
+		}
 		i.Name = name
 	} else {
 		i.Name = income.Name
@@ -150,5 +181,59 @@ func (s *IncomeService) DeleteById(ctx context.Context, id int, userId int) erro
 		}
 	}
 
-	return s.repo.DeleteById(ctx, id, userId)
+	if s.validation.FreezeDate != nil {
+		income, err := s.repo.FindById(ctx, id, userId)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return &domain.EntityNotFoundError{
+					UnderlyingCause: err,
+				}
+			}
+			return err
+		}
+		if err := checkNotFrozen(income.CreatedAt, s.validation.FreezeDate); err != nil {
+			return err
+		}
+	}
+
+	deleted, err := s.repo.DeleteById(ctx, id, userId)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return &domain.EntityNotFoundError{
+			UnderlyingCause: pgx.ErrNoRows,
+		}
+	}
+
+	return nil
+}
+
+func (s *IncomeService) GetLargest(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.Income, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	return s.repo.FindLargest(ctx, from, to, userId, limit)
+}
+
+func (s *IncomeService) GetStats(ctx context.Context, from *time.Time, to *time.Time, userId int) (*domain.AmountStats, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	return s.repo.GetStats(ctx, from, to, userId)
+}
+
+func (s *IncomeService) GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error) {
+	return s.repo.GetDateBounds(ctx, userId)
 }
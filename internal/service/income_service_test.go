@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/kerhael/accounting/internal/config"
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/kerhael/accounting/internal/infrastructure/repository/mocks"
 	"github.com/stretchr/testify/assert"
@@ -15,7 +17,7 @@ import (
 
 func TestCreateIncome_Success(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	name := "Restaurant"
@@ -42,7 +44,7 @@ func TestCreateIncome_Success(t *testing.T) {
 
 func TestCreateIncome_InvalidName(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	name := ""
@@ -59,7 +61,7 @@ func TestCreateIncome_InvalidName(t *testing.T) {
 
 func TestCreateIncome_InvalidName_Whitespace(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	name := "   "
@@ -76,7 +78,7 @@ func TestCreateIncome_InvalidName_Whitespace(t *testing.T) {
 
 func TestCreateIncome_InvalidAmount_Zero(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	name := "Restaurant"
@@ -93,7 +95,7 @@ func TestCreateIncome_InvalidAmount_Zero(t *testing.T) {
 
 func TestCreateIncome_InvalidAmount_Negative(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	name := "Restaurant"
@@ -110,7 +112,7 @@ func TestCreateIncome_InvalidAmount_Negative(t *testing.T) {
 
 func TestCreateIncome_InvalidCreatedAt(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	name := "Restaurant"
@@ -127,7 +129,7 @@ func TestCreateIncome_InvalidCreatedAt(t *testing.T) {
 
 func TestCreateIncome_RepoError(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	name := "Restaurant"
@@ -148,7 +150,7 @@ func TestCreateIncome_RepoError(t *testing.T) {
 
 func TestGetAllIncomes_Success(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 	userId := 123
 
@@ -187,7 +189,7 @@ func TestGetAllIncomes_Success(t *testing.T) {
 
 func TestGetAllIncomes_InvalidDates(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	to := time.Now()
@@ -207,7 +209,7 @@ func TestGetAllIncomes_InvalidDates(t *testing.T) {
 
 func TestGetAllIncomes_EmptyList(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	expectedIncomes := []domain.Income{}
@@ -227,7 +229,7 @@ func TestGetAllIncomes_EmptyList(t *testing.T) {
 
 func TestGetAllIncomes_RepoError(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	mockRepo.On("FindAll", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 123, 20, 0).Return([]domain.Income(nil), errors.New("repo error"))
@@ -244,7 +246,7 @@ func TestGetAllIncomes_RepoError(t *testing.T) {
 
 func TestGetAllIncomes_CountError(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -272,7 +274,7 @@ func TestGetAllIncomes_CountError(t *testing.T) {
 
 func TestGetIncomeById_Success(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 	userId := 123
 
@@ -299,7 +301,7 @@ func TestGetIncomeById_Success(t *testing.T) {
 
 func TestGetIncomeById_InvalidId_Zero(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	income, err := service.GetById(ctx, 0, 123)
@@ -313,7 +315,7 @@ func TestGetIncomeById_InvalidId_Zero(t *testing.T) {
 
 func TestGetIncomeById_InvalidId_Negative(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	income, err := service.GetById(ctx, -1, 123)
@@ -327,7 +329,7 @@ func TestGetIncomeById_InvalidId_Negative(t *testing.T) {
 
 func TestGetIncomeById_NotFound(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	mockRepo.On("FindById", ctx, 999, 123).Return((*domain.Income)(nil), pgx.ErrNoRows)
@@ -343,7 +345,7 @@ func TestGetIncomeById_NotFound(t *testing.T) {
 
 func TestGetIncomeById_RepoError(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	repoErr := errors.New("repo error")
@@ -360,7 +362,7 @@ func TestGetIncomeById_RepoError(t *testing.T) {
 
 func TestPatchIncomeById_Success_NameOnly(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 	userId := 123
 
@@ -397,7 +399,7 @@ func TestPatchIncomeById_Success_NameOnly(t *testing.T) {
 
 func TestPatchIncomeById_Success_AllFields(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 	userId := 123
 
@@ -431,9 +433,23 @@ func TestPatchIncomeById_Success_AllFields(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestPatchIncomeById_NegativeAmount(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	income, err := service.PatchById(ctx, 1, "", -100, nil, 123)
+
+	assert.Nil(t, income)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.EqualError(t, err, "invalid entity data: amount must be positive")
+
+	mockRepo.AssertNotCalled(t, "FindById")
+}
+
 func TestPatchIncomeById_NotFound(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	mockRepo.On("FindById", ctx, 999, 123).Return((*domain.Income)(nil), pgx.ErrNoRows)
@@ -449,7 +465,7 @@ func TestPatchIncomeById_NotFound(t *testing.T) {
 
 func TestPatchIncomeById_UpdateError(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -475,10 +491,10 @@ func TestPatchIncomeById_UpdateError(t *testing.T) {
 
 func TestIncomeDeleteById_Success(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
-	mockRepo.On("DeleteById", ctx, 1, 123).Return(nil)
+	mockRepo.On("DeleteById", ctx, 1, 123).Return(int64(1), nil)
 
 	err := service.DeleteById(ctx, 1, 123)
 
@@ -487,9 +503,24 @@ func TestIncomeDeleteById_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestIncomeDeleteById_NotFound(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockRepo.On("DeleteById", ctx, 99, 123).Return(int64(0), nil)
+
+	err := service.DeleteById(ctx, 99, 123)
+
+	assert.Error(t, err)
+	assert.IsType(t, &domain.EntityNotFoundError{}, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
 func TestIncomeDeleteById_InvalidId_Zero(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	err := service.DeleteById(ctx, 0, 123)
@@ -502,7 +533,7 @@ func TestIncomeDeleteById_InvalidId_Zero(t *testing.T) {
 
 func TestIncomeDeleteById_InvalidId_Negative(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	err := service.DeleteById(ctx, -1, 123)
@@ -515,11 +546,11 @@ func TestIncomeDeleteById_InvalidId_Negative(t *testing.T) {
 
 func TestIncomeDeleteById_RepoError(t *testing.T) {
 	mockRepo := new(mocks.IncomeRepository)
-	service := NewIncomeService(mockRepo)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	repoErr := errors.New("repo error")
-	mockRepo.On("DeleteById", ctx, 1, 123).Return(repoErr)
+	mockRepo.On("DeleteById", ctx, 1, 123).Return(int64(0), repoErr)
 
 	err := service.DeleteById(ctx, 1, 123)
 
@@ -528,3 +559,205 @@ func TestIncomeDeleteById_RepoError(t *testing.T) {
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestPatchIncomeById_BeforeFreezeDate(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, freezeValidationConfig())
+	ctx := context.Background()
+
+	createdAt := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Income{ID: 1, Name: "Salary", Amount: 300000, CreatedAt: &createdAt, UserId: 123}, nil)
+
+	income, err := service.PatchById(ctx, 1, "Bonus", 0, nil, 123)
+
+	assert.Nil(t, income)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestPatchIncomeById_AfterFreezeDate(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, freezeValidationConfig())
+	ctx := context.Background()
+
+	createdAt := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Income{ID: 1, Name: "Salary", Amount: 300000, CreatedAt: &createdAt, UserId: 123}, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.Income")).Return(nil)
+
+	income, err := service.PatchById(ctx, 1, "Bonus", 0, nil, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Bonus", income.Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestIncomeDeleteById_BeforeFreezeDate(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, freezeValidationConfig())
+	ctx := context.Background()
+
+	createdAt := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Income{ID: 1, CreatedAt: &createdAt, UserId: 123}, nil)
+
+	err := service.DeleteById(ctx, 1, 123)
+
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "DeleteById", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestIncomeDeleteById_AfterFreezeDate(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, freezeValidationConfig())
+	ctx := context.Background()
+
+	createdAt := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Income{ID: 1, CreatedAt: &createdAt, UserId: 123}, nil)
+	mockRepo.On("DeleteById", ctx, 1, 123).Return(int64(1), nil)
+
+	err := service.DeleteById(ctx, 1, 123)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetLargestIncomes_Success(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+	userId := 123
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	expectedIncomes := []domain.Income{
+		{ID: 2, Name: "Salary", Amount: 250000, UserId: userId},
+		{ID: 1, Name: "Bonus", Amount: 50000, UserId: userId},
+	}
+	mockRepo.On("FindLargest", ctx, &from, &to, userId, 5).Return(expectedIncomes, nil)
+
+	incomes, err := service.GetLargest(ctx, &from, &to, userId, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIncomes, incomes)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetLargestIncomes_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
+
+	incomes, err := service.GetLargest(ctx, &from, &to, 123, 5)
+
+	assert.Error(t, err)
+	assert.Nil(t, incomes)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+	mockRepo.AssertNotCalled(t, "FindLargest")
+}
+
+func TestGetIncomesStats_Success(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+	userId := 123
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	expectedStats := &domain.AmountStats{Count: 4, Min: 1000, Max: 9000, Average: 4000, Median: 3000}
+	mockRepo.On("GetStats", ctx, &from, &to, userId).Return(expectedStats, nil)
+
+	stats, err := service.GetStats(ctx, &from, &to, userId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedStats, stats)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetIncomesStats_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
+
+	stats, err := service.GetStats(ctx, &from, &to, 123)
+
+	assert.Error(t, err)
+	assert.Nil(t, stats)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+	mockRepo.AssertNotCalled(t, "GetStats")
+}
+
+func TestCreateIncome_NameAtMaxLength(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.ValidationConfig{MaxNameLength: 10})
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	name := strings.Repeat("é", 10)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Income")).Return(nil)
+
+	income, err := service.Create(ctx, " "+name+" ", 1000, &createdAt, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, name, income.Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateIncome_NameOverMaxLength(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.ValidationConfig{MaxNameLength: 10})
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	income, err := service.Create(ctx, strings.Repeat("a", 11), 1000, &createdAt, 123)
+
+	assert.Nil(t, income)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestPatchIncomeById_NameOverMaxLength(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.ValidationConfig{MaxNameLength: 10})
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Income{ID: 1, Name: "Salary", Amount: 1000, UserId: 123}, nil)
+
+	income, err := service.PatchById(ctx, 1, strings.Repeat("a", 11), 0, nil, 123)
+
+	assert.Nil(t, income)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCreateIncome_NumericName_RejectedWithFlag(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.ValidationConfig{RejectNumericNames: true})
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	income, err := service.Create(ctx, "2026", 1000, &createdAt, 123)
+
+	assert.Nil(t, income)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestCreateIncome_NumericName_AllowedWithoutFlag(t *testing.T) {
+	mockRepo := new(mocks.IncomeRepository)
+	service := NewIncomeService(mockRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Income")).Return(nil)
+
+	income, err := service.Create(ctx, "2026", 1000, &createdAt, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2026", income.Name)
+	mockRepo.AssertExpectations(t)
+}
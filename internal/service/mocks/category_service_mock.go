@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -11,8 +12,8 @@ type CategoryService struct {
 	mock.Mock
 }
 
-func (m *CategoryService) Create(ctx context.Context, label string, userId int) (*domain.Category, error) {
-	args := m.Called(ctx, label, userId)
+func (m *CategoryService) Create(ctx context.Context, label string, color string, userId int) (*domain.Category, error) {
+	args := m.Called(ctx, label, color, userId)
 	if cat, ok := args.Get(0).(*domain.Category); ok {
 		return cat, args.Error(1)
 	}
@@ -29,6 +30,26 @@ func (m *CategoryService) GetAll(ctx context.Context, userId int) ([]domain.Cate
 	return categories, args.Error(1)
 }
 
+func (m *CategoryService) GetAllBySpend(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.Category, error) {
+	args := m.Called(ctx, from, to, userId)
+	var categories []domain.Category
+	if args.Get(0) != nil {
+		categories = args.Get(0).([]domain.Category)
+	}
+
+	return categories, args.Error(1)
+}
+
+func (m *CategoryService) GetArchiveSuggestions(ctx context.Context, staleMonths int, userId int) ([]domain.CategoryUsage, error) {
+	args := m.Called(ctx, staleMonths, userId)
+	var usages []domain.CategoryUsage
+	if args.Get(0) != nil {
+		usages = args.Get(0).([]domain.CategoryUsage)
+	}
+
+	return usages, args.Error(1)
+}
+
 func (m *CategoryService) GetById(ctx context.Context, id int, userId int) (*domain.Category, error) {
 	args := m.Called(ctx, id, userId)
 	if cat, ok := args.Get(0).(*domain.Category); ok {
@@ -37,6 +58,32 @@ func (m *CategoryService) GetById(ctx context.Context, id int, userId int) (*dom
 	return nil, args.Error(1)
 }
 
+func (m *CategoryService) PatchById(ctx context.Context, id int, label string, color *string, userId int) (*domain.Category, error) {
+	args := m.Called(ctx, id, label, color, userId)
+	if cat, ok := args.Get(0).(*domain.Category); ok {
+		return cat, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *CategoryService) Clone(ctx context.Context, id int, label string, userId int) (*domain.Category, error) {
+	args := m.Called(ctx, id, label, userId)
+	if cat, ok := args.Get(0).(*domain.Category); ok {
+		return cat, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *CategoryService) EnsureDefaults(ctx context.Context, userId int) ([]domain.Category, bool, error) {
+	args := m.Called(ctx, userId)
+	var categories []domain.Category
+	if args.Get(0) != nil {
+		categories = args.Get(0).([]domain.Category)
+	}
+
+	return categories, args.Bool(1), args.Error(2)
+}
+
 func (m *CategoryService) DeleteById(ctx context.Context, id int, userId int) error {
 	args := m.Called(ctx, id, userId)
 	return args.Error(0)
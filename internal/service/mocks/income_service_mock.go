@@ -56,3 +56,30 @@ func (m *IncomeService) DeleteById(ctx context.Context, id int, userId int) erro
 	args := m.Called(ctx, id, userId)
 	return args.Error(0)
 }
+
+func (m *IncomeService) GetLargest(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.Income, error) {
+	args := m.Called(ctx, from, to, userId, limit)
+
+	var incomes []domain.Income
+	if args.Get(0) != nil {
+		incomes = args.Get(0).([]domain.Income)
+	}
+
+	return incomes, args.Error(1)
+}
+
+func (m *IncomeService) GetStats(ctx context.Context, from *time.Time, to *time.Time, userId int) (*domain.AmountStats, error) {
+	args := m.Called(ctx, from, to, userId)
+	if stats, ok := args.Get(0).(*domain.AmountStats); ok {
+		return stats, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *IncomeService) GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error) {
+	args := m.Called(ctx, userId)
+	if bounds, ok := args.Get(0).(*domain.DateBounds); ok {
+		return bounds, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
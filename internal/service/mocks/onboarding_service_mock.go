@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type OnboardingService struct {
+	mock.Mock
+}
+
+func (m *OnboardingService) GetStatus(ctx context.Context, userId int) (*domain.OnboardingStatus, error) {
+	args := m.Called(ctx, userId)
+	if status, ok := args.Get(0).(*domain.OnboardingStatus); ok {
+		return status, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -36,6 +36,22 @@ func (m *OutcomeService) GetAll(ctx context.Context, from *time.Time, to *time.T
 	return outcomes, total, args.Error(2)
 }
 
+func (m *OutcomeService) GetAllAfter(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, after *domain.Cursor, limit int) ([]domain.Outcome, *domain.Cursor, int, error) {
+	args := m.Called(ctx, from, to, categoryId, userId, after, limit)
+
+	var outcomes []domain.Outcome
+	if args.Get(0) != nil {
+		outcomes = args.Get(0).([]domain.Outcome)
+	}
+
+	var next *domain.Cursor
+	if args.Get(1) != nil {
+		next = args.Get(1).(*domain.Cursor)
+	}
+
+	return outcomes, next, args.Int(2), args.Error(3)
+}
+
 func (m *OutcomeService) GetById(ctx context.Context, id int, userId int) (*domain.Outcome, error) {
 	args := m.Called(ctx, id, userId)
 	if outcome, ok := args.Get(0).(*domain.Outcome); ok {
@@ -52,6 +68,17 @@ func (m *OutcomeService) PatchById(ctx context.Context, id int, name string, amo
 	return nil, args.Error(1)
 }
 
+func (m *OutcomeService) BulkPatch(ctx context.Context, patches []domain.OutcomePatch, userId int) ([]domain.Outcome, error) {
+	args := m.Called(ctx, patches, userId)
+
+	var outcomes []domain.Outcome
+	if args.Get(0) != nil {
+		outcomes = args.Get(0).([]domain.Outcome)
+	}
+
+	return outcomes, args.Error(1)
+}
+
 func (m *OutcomeService) DeleteById(ctx context.Context, id int, userId int) error {
 	args := m.Called(ctx, id, userId)
 	return args.Error(0)
@@ -68,6 +95,97 @@ func (m *OutcomeService) GetSum(ctx context.Context, from *time.Time, to *time.T
 	return sums, args.Error(1)
 }
 
+func (m *OutcomeService) GetAverageByCategory(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.CategoryAverage, error) {
+	args := m.Called(ctx, from, to, userId)
+
+	var averages []domain.CategoryAverage
+	if args.Get(0) != nil {
+		averages = args.Get(0).([]domain.CategoryAverage)
+	}
+
+	return averages, args.Error(1)
+}
+
+func (m *OutcomeService) GetOutliers(ctx context.Context, from *time.Time, to *time.Time, factor float64, userId int) ([]domain.OutcomeOutlier, error) {
+	args := m.Called(ctx, from, to, factor, userId)
+
+	var outliers []domain.OutcomeOutlier
+	if args.Get(0) != nil {
+		outliers = args.Get(0).([]domain.OutcomeOutlier)
+	}
+
+	return outliers, args.Error(1)
+}
+
+func (m *OutcomeService) GetForecast(ctx context.Context, now time.Time, months int, userId int) ([]domain.MonthlyForecast, error) {
+	args := m.Called(ctx, now, months, userId)
+
+	var forecast []domain.MonthlyForecast
+	if args.Get(0) != nil {
+		forecast = args.Get(0).([]domain.MonthlyForecast)
+	}
+
+	return forecast, args.Error(1)
+}
+
+func (m *OutcomeService) GetStreaks(ctx context.Context, from time.Time, to time.Time, loc *time.Location, userId int) (*domain.SpendingStreaks, error) {
+	args := m.Called(ctx, from, to, loc, userId)
+	if streaks, ok := args.Get(0).(*domain.SpendingStreaks); ok {
+		return streaks, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *OutcomeService) GetGroupedSums(ctx context.Context, from time.Time, to time.Time, interval domain.Interval, loc *time.Location, userId int) ([]domain.PeriodSum, error) {
+	args := m.Called(ctx, from, to, interval, loc, userId)
+	if sums, ok := args.Get(0).([]domain.PeriodSum); ok {
+		return sums, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *OutcomeService) GetFrequentNames(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.NameFrequency, error) {
+	args := m.Called(ctx, from, to, userId, limit)
+	var frequencies []domain.NameFrequency
+	if args.Get(0) != nil {
+		frequencies = args.Get(0).([]domain.NameFrequency)
+	}
+
+	return frequencies, args.Error(1)
+}
+
+func (m *OutcomeService) GetHistogram(ctx context.Context, from *time.Time, to *time.Time, bounds []int, userId int) ([]domain.AmountBucket, error) {
+	args := m.Called(ctx, from, to, bounds, userId)
+	var buckets []domain.AmountBucket
+	if args.Get(0) != nil {
+		buckets = args.Get(0).([]domain.AmountBucket)
+	}
+
+	return buckets, args.Error(1)
+}
+
+func (m *OutcomeService) GetSumByHour(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.HourlySum, error) {
+	args := m.Called(ctx, from, to, tz, userId)
+
+	var sums []domain.HourlySum
+	if args.Get(0) != nil {
+		sums = args.Get(0).([]domain.HourlySum)
+	}
+
+	return sums, args.Error(1)
+}
+
+func (m *OutcomeService) GetRollingTotal(ctx context.Context, now time.Time, days int, userId int) (*domain.RollingTotal, error) {
+	args := m.Called(ctx, now, days, userId)
+
+	var rolling *domain.RollingTotal
+	if args.Get(0) != nil {
+		rolling = args.Get(0).(*domain.RollingTotal)
+	}
+
+	return rolling, args.Error(1)
+}
+
 func (m *OutcomeService) GetTotal(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error) {
 	args := m.Called(ctx, from, to, userId)
 
@@ -100,3 +218,63 @@ func (m *OutcomeService) GetTotalSeries(ctx context.Context, from *time.Time, to
 
 	return series, args.Error(1)
 }
+
+func (m *OutcomeService) GetNewCategories(ctx context.Context, month time.Time, userId int) ([]domain.CategorySum, error) {
+	args := m.Called(ctx, month, userId)
+
+	var sums []domain.CategorySum
+	if args.Get(0) != nil {
+		sums = args.Get(0).([]domain.CategorySum)
+	}
+
+	return sums, args.Error(1)
+}
+
+func (m *OutcomeService) Export(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) ([]domain.ExportedOutcome, error) {
+	args := m.Called(ctx, from, to, categoryId, userId)
+
+	var outcomes []domain.ExportedOutcome
+	if args.Get(0) != nil {
+		outcomes = args.Get(0).([]domain.ExportedOutcome)
+	}
+
+	return outcomes, args.Error(1)
+}
+
+func (m *OutcomeService) GetMonthlyCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyCount, error) {
+	args := m.Called(ctx, from, to, userId)
+
+	var counts []domain.MonthlyCount
+	if args.Get(0) != nil {
+		counts = args.Get(0).([]domain.MonthlyCount)
+	}
+
+	return counts, args.Error(1)
+}
+
+func (m *OutcomeService) GetYearOverYear(ctx context.Context, month time.Time, userId int) (*domain.YearOverYear, error) {
+	args := m.Called(ctx, month, userId)
+
+	var yoy *domain.YearOverYear
+	if args.Get(0) != nil {
+		yoy = args.Get(0).(*domain.YearOverYear)
+	}
+
+	return yoy, args.Error(1)
+}
+
+func (m *OutcomeService) GetProjection(ctx context.Context, now time.Time, userId int) (*domain.SpendingProjection, error) {
+	args := m.Called(ctx, now, userId)
+	if projection, ok := args.Get(0).(*domain.SpendingProjection); ok {
+		return projection, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *OutcomeService) GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error) {
+	args := m.Called(ctx, userId)
+	if bounds, ok := args.Get(0).(*domain.DateBounds); ok {
+		return bounds, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
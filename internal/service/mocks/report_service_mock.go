@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type ReportService struct {
+	mock.Mock
+}
+
+func (m *ReportService) GetSpendVsIncome(ctx context.Context, from time.Time, to time.Time, userId int) (*domain.SpendVsIncome, error) {
+	args := m.Called(ctx, from, to, userId)
+	if report, ok := args.Get(0).(*domain.SpendVsIncome); ok {
+		return report, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ReportService) GetPayPeriodBalance(ctx context.Context, now time.Time, anchor time.Time, lengthDays int, userId int) (*domain.PayPeriodBalance, error) {
+	args := m.Called(ctx, now, anchor, lengthDays, userId)
+	if balance, ok := args.Get(0).(*domain.PayPeriodBalance); ok {
+		return balance, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ReportService) GetSavingsRate(ctx context.Context, from time.Time, to time.Time, userId int) ([]domain.MonthlySavingsRate, error) {
+	args := m.Called(ctx, from, to, userId)
+	if rates, ok := args.Get(0).([]domain.MonthlySavingsRate); ok {
+		return rates, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ReportService) GetMonthlyStatement(ctx context.Context, from time.Time, to time.Time, userId int) (*domain.MonthlyStatement, error) {
+	args := m.Called(ctx, from, to, userId)
+	if statement, ok := args.Get(0).(*domain.MonthlyStatement); ok {
+		return statement, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type TransactionService struct {
+	mock.Mock
+}
+
+func (m *TransactionService) Search(ctx context.Context, term string, userId int, limit int, offset int) ([]domain.Transaction, int, error) {
+	args := m.Called(ctx, term, userId, limit, offset)
+
+	var transactions []domain.Transaction
+	if args.Get(0) != nil {
+		transactions = args.Get(0).([]domain.Transaction)
+	}
+
+	return transactions, args.Int(1), args.Error(2)
+}
@@ -35,8 +35,8 @@ func (m *UserService) FindById(ctx context.Context, id int) (*domain.User, error
 	return nil, args.Error(1)
 }
 
-func (m *UserService) PatchById(ctx context.Context, id int, firstName string, lastName string, password string) (*domain.User, error) {
-	args := m.Called(ctx, id, firstName, lastName, password)
+func (m *UserService) PatchById(ctx context.Context, id int, firstName string, lastName string, password string, defaultCategoryId *int, requireCategory *bool) (*domain.User, error) {
+	args := m.Called(ctx, id, firstName, lastName, password, defaultCategoryId, requireCategory)
 	if income, ok := args.Get(0).(*domain.User); ok {
 		return income, args.Error(1)
 	}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/infrastructure/repository"
+)
+
+type OnboardingServiceInterface interface {
+	GetStatus(ctx context.Context, userId int) (*domain.OnboardingStatus, error)
+}
+
+type OnboardingService struct {
+	categoryRepo repository.CategoryRepository
+	incomeRepo   repository.IncomeRepository
+	outcomeRepo  repository.OutcomeRepository
+}
+
+func NewOnboardingService(categoryRepo repository.CategoryRepository, incomeRepo repository.IncomeRepository, outcomeRepo repository.OutcomeRepository) *OnboardingService {
+	return &OnboardingService{categoryRepo: categoryRepo, incomeRepo: incomeRepo, outcomeRepo: outcomeRepo}
+}
+
+func (s *OnboardingService) GetStatus(ctx context.Context, userId int) (*domain.OnboardingStatus, error) {
+	hasCategories, err := s.categoryRepo.ExistsByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	hasIncome, err := s.incomeRepo.ExistsByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	hasOutcome, err := s.outcomeRepo.ExistsByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OnboardingStatus{
+		HasCategories: hasCategories,
+		HasIncome:     hasIncome,
+		HasOutcome:    hasOutcome,
+	}, nil
+}
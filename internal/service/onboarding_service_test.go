@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kerhael/accounting/internal/infrastructure/repository/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnboardingService_GetStatus_Success(t *testing.T) {
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewOnboardingService(mockCategoryRepo, mockIncomeRepo, mockOutcomeRepo)
+	ctx := context.Background()
+
+	mockCategoryRepo.On("ExistsByUser", ctx, 123).Return(true, nil)
+	mockIncomeRepo.On("ExistsByUser", ctx, 123).Return(false, nil)
+	mockOutcomeRepo.On("ExistsByUser", ctx, 123).Return(true, nil)
+
+	status, err := service.GetStatus(ctx, 123)
+
+	assert.NoError(t, err)
+	assert.True(t, status.HasCategories)
+	assert.False(t, status.HasIncome)
+	assert.True(t, status.HasOutcome)
+
+	mockCategoryRepo.AssertExpectations(t)
+	mockIncomeRepo.AssertExpectations(t)
+	mockOutcomeRepo.AssertExpectations(t)
+}
+
+func TestOnboardingService_GetStatus_RepoError(t *testing.T) {
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewOnboardingService(mockCategoryRepo, mockIncomeRepo, mockOutcomeRepo)
+	ctx := context.Background()
+
+	mockCategoryRepo.On("ExistsByUser", ctx, 123).Return(false, errors.New("db error"))
+
+	status, err := service.GetStatus(ctx, 123)
+
+	assert.Error(t, err)
+	assert.Nil(t, status)
+	mockIncomeRepo.AssertNotCalled(t, "ExistsByUser")
+	mockOutcomeRepo.AssertNotCalled(t, "ExistsByUser")
+}
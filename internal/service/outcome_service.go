@@ -3,10 +3,15 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/kerhael/accounting/internal/config"
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/kerhael/accounting/internal/infrastructure/repository"
 )
@@ -14,22 +19,40 @@ import (
 type OutcomeServiceInterface interface {
 	Create(ctx context.Context, name string, amount int, categoryId int, createdAt *time.Time, userId int) (*domain.Outcome, error)
 	GetAll(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, limit int, offset int) ([]domain.Outcome, int, error)
+	GetAllAfter(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, after *domain.Cursor, limit int) ([]domain.Outcome, *domain.Cursor, int, error)
+	Export(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) ([]domain.ExportedOutcome, error)
 	GetById(ctx context.Context, id int, userId int) (*domain.Outcome, error)
 	PatchById(ctx context.Context, id int, name string, amount int, categoryId int, createdAt *time.Time, userId int) (*domain.Outcome, error)
+	BulkPatch(ctx context.Context, patches []domain.OutcomePatch, userId int) ([]domain.Outcome, error)
 	DeleteById(ctx context.Context, id int, userId int) error
 	GetSum(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) ([]domain.CategorySum, error)
+	GetAverageByCategory(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.CategoryAverage, error)
+	GetOutliers(ctx context.Context, from *time.Time, to *time.Time, factor float64, userId int) ([]domain.OutcomeOutlier, error)
+	GetSumByHour(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.HourlySum, error)
+	GetFrequentNames(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.NameFrequency, error)
+	GetHistogram(ctx context.Context, from *time.Time, to *time.Time, bounds []int, userId int) ([]domain.AmountBucket, error)
+	GetForecast(ctx context.Context, now time.Time, months int, userId int) ([]domain.MonthlyForecast, error)
+	GetStreaks(ctx context.Context, from time.Time, to time.Time, loc *time.Location, userId int) (*domain.SpendingStreaks, error)
+	GetGroupedSums(ctx context.Context, from time.Time, to time.Time, interval domain.Interval, loc *time.Location, userId int) ([]domain.PeriodSum, error)
+	GetRollingTotal(ctx context.Context, now time.Time, days int, userId int) (*domain.RollingTotal, error)
 	GetTotal(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error)
 	GetSeries(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlySeries, error)
 	GetTotalSeries(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyTotalSeries, error)
+	GetMonthlyCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyCount, error)
+	GetNewCategories(ctx context.Context, month time.Time, userId int) ([]domain.CategorySum, error)
+	GetYearOverYear(ctx context.Context, month time.Time, userId int) (*domain.YearOverYear, error)
+	GetProjection(ctx context.Context, now time.Time, userId int) (*domain.SpendingProjection, error)
+	GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error)
 }
 
 type OutcomeService struct {
 	repo         repository.OutcomeRepository
 	categoryRepo repository.CategoryRepository
+	validation   config.ValidationConfig
 }
 
-func NewOutcomeService(repo repository.OutcomeRepository, categoryRepo repository.CategoryRepository) *OutcomeService {
-	return &OutcomeService{repo: repo, categoryRepo: categoryRepo}
+func NewOutcomeService(repo repository.OutcomeRepository, categoryRepo repository.CategoryRepository, validation config.ValidationConfig) *OutcomeService {
+	return &OutcomeService{repo: repo, categoryRepo: categoryRepo, validation: validation}
 }
 
 func (s *OutcomeService) Create(ctx context.Context, name string, amount int, categoryId int, createdAt *time.Time, userId int) (*domain.Outcome, error) {
@@ -39,6 +62,12 @@ func (s *OutcomeService) Create(ctx context.Context, name string, amount int, ca
 			UnderlyingCause: errors.New("invalid name"),
 		}
 	}
+	if err := checkMaxLength("name", name, s.validation.MaxNameLength); err != nil {
+		return nil, err
+	}
+	if err := checkNotNumeric("name", name, s.validation.RejectNumericNames); err != nil {
+		return nil, err
+	}
 
 	if amount <= 0 {
 		return nil, &domain.InvalidEntityError{
@@ -47,16 +76,30 @@ func (s *OutcomeService) Create(ctx context.Context, name string, amount int, ca
 	}
 
 	if categoryId == 0 {
-		return nil, &domain.InvalidEntityError{
-			UnderlyingCause: errors.New("invalid category"),
+		// Quick entry: fall back to the user's default category
+		category, err := s.categoryRepo.FindDefault(ctx, userId)
+		if err != nil && err != pgx.ErrNoRows {
+			return nil, err
+		}
+		if category != nil {
+			categoryId = category.ID
+		}
+	} else {
+		_, err := s.categoryRepo.FindById(ctx, categoryId, userId)
+		if err != nil {
+			return nil, &domain.InvalidEntityError{
+				UnderlyingCause: errors.New("invalid category"),
+			}
 		}
 	}
-	_, err := s.categoryRepo.FindById(ctx, categoryId, userId)
-	if err != nil {
-		return nil, &domain.InvalidEntityError{
-			UnderlyingCause: errors.New("invalid category"),
+	if categoryId == 0 {
+		if err := s.checkCategoryNotRequired(ctx, userId); err != nil {
+			return nil, err
 		}
 	}
+	if err := s.checkAllowedCategory(ctx, categoryId, userId); err != nil {
+		return nil, err
+	}
 
 	if createdAt == nil {
 		return nil, &domain.InvalidEntityError{
@@ -80,19 +123,9 @@ func (s *OutcomeService) Create(ctx context.Context, name string, amount int, ca
 }
 
 func (s *OutcomeService) GetAll(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, limit int, offset int) ([]domain.Outcome, int, error) {
-	if from != nil && to != nil && from.After(*to) {
-		return nil, 0, &domain.InvalidDateError{
-			UnderlyingCause: errors.New("start date must be before end date"),
-		}
-	}
-
-	if categoryId != 0 {
-		_, err := s.categoryRepo.FindById(ctx, categoryId, userId)
-		if err != nil {
-			return nil, 0, &domain.InvalidEntityError{
-				UnderlyingCause: errors.New("invalid category"),
-			}
-		}
+	to, err := s.checkListFilters(ctx, from, to, categoryId, userId)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	outcomes, err := s.repo.FindAll(ctx, from, to, categoryId, userId, limit, offset)
@@ -108,6 +141,102 @@ func (s *OutcomeService) GetAll(ctx context.Context, from *time.Time, to *time.T
 	return outcomes, total, nil
 }
 
+// GetAllAfter returns the page of outcomes following the after cursor (the first page when nil)
+// along with the cursor of the next page, nil on the last page.
+func (s *OutcomeService) GetAllAfter(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int, after *domain.Cursor, limit int) ([]domain.Outcome, *domain.Cursor, int, error) {
+	to, err := s.checkListFilters(ctx, from, to, categoryId, userId)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// One extra row tells whether a next page exists
+	outcomes, err := s.repo.FindAllAfter(ctx, from, to, categoryId, userId, after, limit+1)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	var next *domain.Cursor
+	if len(outcomes) > limit {
+		outcomes = outcomes[:limit]
+		last := outcomes[limit-1]
+		next = &domain.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}
+	}
+
+	total, err := s.repo.CountAll(ctx, from, to, categoryId, userId)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return outcomes, next, total, nil
+}
+
+// exportPageSize is the number of outcomes read at once while exporting.
+const exportPageSize = 500
+
+// Export returns every outcome matching the filters, newest first, along with
+// their category labels. Outcomes are read page by page to keep queries short,
+// and the export is rejected past domain.MaxExportedOutcomes.
+func (s *OutcomeService) Export(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) ([]domain.ExportedOutcome, error) {
+	to, err := s.checkListFilters(ctx, from, to, categoryId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepo.FindAll(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[int]string, len(categories))
+	for _, c := range categories {
+		labels[c.ID] = c.Label
+	}
+
+	exported := []domain.ExportedOutcome{}
+	var after *domain.Cursor
+	for {
+		outcomes, err := s.repo.FindAllAfter(ctx, from, to, categoryId, userId, after, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range outcomes {
+			exported = append(exported, domain.ExportedOutcome{Outcome: o, CategoryLabel: labels[o.CategoryId]})
+		}
+		if len(exported) > domain.MaxExportedOutcomes {
+			return nil, &domain.InvalidDateError{
+				UnderlyingCause: fmt.Errorf("cannot export more than %d outcomes, narrow the date range", domain.MaxExportedOutcomes),
+			}
+		}
+
+		if len(outcomes) < exportPageSize {
+			return exported, nil
+		}
+		last := outcomes[len(outcomes)-1]
+		after = &domain.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}
+	}
+}
+
+// checkListFilters validates the filters shared by the outcome listings and
+// returns the end date to use.
+func (s *OutcomeService) checkListFilters(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) (*time.Time, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	if categoryId != 0 {
+		_, err := s.categoryRepo.FindById(ctx, categoryId, userId)
+		if err != nil {
+			return nil, &domain.InvalidEntityError{
+				UnderlyingCause: errors.New("invalid category"),
+			}
+		}
+	}
+
+	return clampFutureTo(to, s.validation.ClampFutureTo), nil
+}
+
 func (s *OutcomeService) GetById(ctx context.Context, id int, userId int) (*domain.Outcome, error) {
 	if id <= 0 {
 		return nil, &domain.InvalidEntityError{
@@ -129,7 +258,63 @@ func (s *OutcomeService) GetById(ctx context.Context, id int, userId int) (*doma
 }
 
 func (s *OutcomeService) PatchById(ctx context.Context, id int, name string, amount int, categoryId int, createdAt *time.Time, userId int) (*domain.Outcome, error) {
-	outcome, err := s.repo.FindById(ctx, id, userId)
+	return s.patch(ctx, s.repo, domain.OutcomePatch{
+		ID:         id,
+		Name:       name,
+		Amount:     amount,
+		CategoryId: categoryId,
+		CreatedAt:  createdAt,
+	}, userId)
+}
+
+// BulkPatch applies all patches in a single transaction. If any patch fails,
+// nothing is applied and a BulkError lists the failures by index.
+func (s *OutcomeService) BulkPatch(ctx context.Context, patches []domain.OutcomePatch, userId int) ([]domain.Outcome, error) {
+	var outcomes []domain.Outcome
+
+	err := s.repo.WithinTx(ctx, func(repo repository.OutcomeRepository) error {
+		outcomes = make([]domain.Outcome, 0, len(patches))
+		bulkErr := &domain.BulkError{}
+
+		for i, p := range patches {
+			outcome, err := s.patch(ctx, repo, p, userId)
+			if err != nil {
+				_, invalid := errors.AsType[*domain.InvalidEntityError](err)
+				_, notFound := errors.AsType[*domain.EntityNotFoundError](err)
+				if !invalid && !notFound {
+					return err
+				}
+				bulkErr.Errors = append(bulkErr.Errors, domain.IndexedError{Index: i, Err: err})
+				continue
+			}
+			outcomes = append(outcomes, *outcome)
+		}
+
+		if len(bulkErr.Errors) > 0 {
+			return bulkErr
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outcomes, nil
+}
+
+func (s *OutcomeService) patch(ctx context.Context, repo repository.OutcomeRepository, p domain.OutcomePatch, userId int) (*domain.Outcome, error) {
+	if p.Amount < 0 {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("amount must be positive"),
+		}
+	}
+	if p.CategoryId < 0 {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("invalid category"),
+		}
+	}
+
+	outcome, err := repo.FindById(ctx, p.ID, userId)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, &domain.EntityNotFoundError{
@@ -138,43 +323,76 @@ func (s *OutcomeService) PatchById(ctx context.Context, id int, name string, amo
 		}
 		return nil, err
 	}
+	// The lookup is scoped to the user already, this guards against a repository
+	// handing back another user's outcome: it is reported as missing so its
+	// existence isn't leaked, and the owner is never taken from the patch.
+	if outcome.UserId != userId {
+		return nil, &domain.EntityNotFoundError{
+			UnderlyingCause: errors.New("outcome not found"),
+		}
+	}
+	if err := checkNotFrozen(outcome.CreatedAt, s.validation.FreezeDate); err != nil {
+		return nil, err
+	}
+	if err := checkNotFrozen(p.CreatedAt, s.validation.FreezeDate); err != nil {
+		return nil, err
+	}
 
 	o := &domain.Outcome{
 		ID:     outcome.ID,
-		UserId: outcome.UserId,
+		UserId: userId,
 	}
 
-	if name != "" {
-		o.Name = name
+	if p.Name != "" {
+		if err := checkMaxLength("name", p.Name, s.validation.MaxNameLength); err != nil {
+			return nil, err
+		}
+		if err := checkNotNumeric("name", p.Name, s.validation.RejectNumericNames); err != nil {
+			return nil, err
+		}
+		o.Name = p.Name
 	} else {
 		o.Name = outcome.Name
 	}
 
-	if amount != 0 {
-		o.Amount = amount
+	if p.Amount != 0 {
+		o.Amount = p.Amount
 	} else {
 		o.Amount = outcome.Amount
 	}
 
-	if categoryId != 0 {
-		_, err := s.categoryRepo.FindById(ctx, categoryId, userId)
+	if p.CategoryId != 0 {
+		_, err := s.categoryRepo.FindById(ctx, p.CategoryId, userId)
 		if err != nil {
 			return nil, &domain.InvalidEntityError{
 				UnderlyingCause: errors.New("invalid category"),
 			}
 		}
-		o.CategoryId = categoryId
+		if err := s.checkAllowedCategory(ctx, p.CategoryId, userId); err != nil {
+			return nil, err
+		}
+		o.CategoryId = p.CategoryId
 	} else {
 		o.CategoryId = outcome.CategoryId
 	}
 
-	if createdAt != nil {
-		o.CreatedAt = createdAt
+	if o.CategoryId == 0 {
+		// Left uncategorized while categories were optional or unrestricted
+		if err := s.checkCategoryNotRequired(ctx, userId); err != nil {
+			return nil, err
+		}
+		if err := s.checkAllowedCategory(ctx, 0, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.CreatedAt != nil {
+		o.CreatedAt = p.CreatedAt
 	} else {
 		o.CreatedAt = outcome.CreatedAt
 	}
 
-	errUpdt := s.repo.Update(ctx, o)
+	errUpdt := repo.Update(ctx, o)
 	if errUpdt != nil {
 		return nil, errUpdt
 	}
@@ -182,6 +400,51 @@ func (s *OutcomeService) PatchById(ctx context.Context, id int, name string, amo
 	return o, nil
 }
 
+// checkCategoryNotRequired rejects an uncategorized outcome when the user requires
+// outcomes to have a category.
+func (s *OutcomeService) checkCategoryNotRequired(ctx context.Context, userId int) error {
+	required, err := s.categoryRepo.IsCategoryRequired(ctx, userId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &domain.EntityNotFoundError{
+				UnderlyingCause: errors.New("user not found"),
+			}
+		}
+		return err
+	}
+	if required {
+		return &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("category is required"),
+		}
+	}
+	return nil
+}
+
+// checkAllowedCategory rejects a category outside the user's allowed categories,
+// categoryId 0 (uncategorized) being outside any of them. A user without allowed
+// categories is unrestricted.
+func (s *OutcomeService) checkAllowedCategory(ctx context.Context, categoryId int, userId int) error {
+	allowedIds, err := s.categoryRepo.FindAllowedIds(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if len(allowedIds) == 0 {
+		return nil
+	}
+
+	if categoryId == 0 {
+		return &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("category is required for this user"),
+		}
+	}
+	if !slices.Contains(allowedIds, categoryId) {
+		return &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("category not allowed for this user"),
+		}
+	}
+	return nil
+}
+
 func (s *OutcomeService) DeleteById(ctx context.Context, id int, userId int) error {
 	if id <= 0 {
 		return &domain.InvalidEntityError{
@@ -189,7 +452,33 @@ func (s *OutcomeService) DeleteById(ctx context.Context, id int, userId int) err
 		}
 	}
 
-	return s.repo.DeleteById(ctx, id, userId)
+	if s.validation.FreezeDate != nil {
+		outcome, err := s.repo.FindById(ctx, id, userId)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return &domain.EntityNotFoundError{
+					UnderlyingCause: err,
+				}
+			}
+			return err
+		}
+		if err := checkNotFrozen(outcome.CreatedAt, s.validation.FreezeDate); err != nil {
+			return err
+		}
+	}
+
+	deleted, err := s.repo.DeleteById(ctx, id, userId)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return &domain.EntityNotFoundError{
+			UnderlyingCause: pgx.ErrNoRows,
+		}
+	}
+
+	return nil
 }
 
 func (s *OutcomeService) GetSum(ctx context.Context, from *time.Time, to *time.Time, categoryId int, userId int) ([]domain.CategorySum, error) {
@@ -199,6 +488,8 @@ func (s *OutcomeService) GetSum(ctx context.Context, from *time.Time, to *time.T
 		}
 	}
 
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
 	if categoryId != 0 {
 		_, err := s.categoryRepo.FindById(ctx, categoryId, userId)
 		if err != nil {
@@ -211,6 +502,239 @@ func (s *OutcomeService) GetSum(ctx context.Context, from *time.Time, to *time.T
 	return s.repo.GetSumByCategory(ctx, from, to, categoryId, userId)
 }
 
+func (s *OutcomeService) GetAverageByCategory(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.CategoryAverage, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	return s.repo.GetAverageByCategory(ctx, from, to, userId)
+}
+
+func (s *OutcomeService) GetOutliers(ctx context.Context, from *time.Time, to *time.Time, factor float64, userId int) ([]domain.OutcomeOutlier, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	if math.IsNaN(factor) || math.IsInf(factor, 0) || factor < 1 {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("factor must be a finite number of at least 1"),
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	return s.repo.FindOutliers(ctx, from, to, factor, userId)
+}
+
+func (s *OutcomeService) GetSumByHour(ctx context.Context, from *time.Time, to *time.Time, tz string, userId int) ([]domain.HourlySum, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	return s.repo.GetSumByHour(ctx, from, to, tz, userId)
+}
+
+// GetFrequentNames returns the outcome names logged the most often between from
+// and to, such as the user's usual merchants.
+func (s *OutcomeService) GetFrequentNames(ctx context.Context, from *time.Time, to *time.Time, userId int, limit int) ([]domain.NameFrequency, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	return s.repo.FindFrequentNames(ctx, from, to, userId, limit)
+}
+
+// GetHistogram spreads the outcomes between from and to into amount buckets, bounds
+// being the ascending lower bounds of the buckets, each bucket ending where the
+// next one starts and the last one being unbounded. Outcomes under the first
+// bound are left out.
+func (s *OutcomeService) GetHistogram(ctx context.Context, from *time.Time, to *time.Time, bounds []int, userId int) ([]domain.AmountBucket, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	if len(bounds) == 0 || len(bounds) > domain.MaxHistogramBuckets {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: fmt.Errorf("between 1 and %d buckets are required", domain.MaxHistogramBuckets),
+		}
+	}
+	for i, bound := range bounds {
+		if bound < 0 {
+			return nil, &domain.InvalidEntityError{
+				UnderlyingCause: errors.New("bucket bounds must be positive"),
+			}
+		}
+		if i > 0 && bound <= bounds[i-1] {
+			return nil, &domain.InvalidEntityError{
+				UnderlyingCause: errors.New("bucket bounds must be in ascending order"),
+			}
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	counts, err := s.repo.GetAmountCounts(ctx, from, to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]domain.AmountBucket, len(bounds))
+	for i, bound := range bounds {
+		buckets[i].Min = bound
+		if i+1 < len(bounds) {
+			upper := bounds[i+1]
+			buckets[i].Max = &upper
+		}
+	}
+
+	for _, c := range counts {
+		// Index of the last bucket starting at or under the amount
+		i, found := slices.BinarySearch(bounds, c.Amount)
+		if !found {
+			i--
+		}
+		if i < 0 {
+			continue
+		}
+		buckets[i].Count += c.Count
+		buckets[i].Total += c.Amount * c.Count
+	}
+
+	return buckets, nil
+}
+
+// GetGroupedSums returns the total of outcomes of each period of the interval
+// between from and to, periods being taken in loc. The range may span at most
+// domain.MaxGroupedPeriods periods.
+func (s *OutcomeService) GetGroupedSums(ctx context.Context, from time.Time, to time.Time, interval domain.Interval, loc *time.Location, userId int) ([]domain.PeriodSum, error) {
+	if !slices.Contains(domain.Intervals, interval) {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("interval must be one of day, week, month, quarter or year"),
+		}
+	}
+
+	if from.After(to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = *clampFutureTo(&to, s.validation.ClampFutureTo)
+
+	if countPeriods(from.In(loc), to.In(loc), interval) > domain.MaxGroupedPeriods {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: fmt.Errorf("range must span at most %d periods, use a longer interval", domain.MaxGroupedPeriods),
+		}
+	}
+
+	return s.repo.GetSumByInterval(ctx, from, to, interval, loc.String(), userId)
+}
+
+// countPeriods returns the number of periods of the interval overlapping from
+// and to, counting no further than domain.MaxGroupedPeriods + 1.
+func countPeriods(from time.Time, to time.Time, interval domain.Interval) int {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	years, months, days := 0, 0, 0
+	switch interval {
+	case domain.IntervalDay:
+		days = 1
+	case domain.IntervalWeek:
+		// Weeks start on Monday, as with date_trunc
+		start = start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+		days = 7
+	case domain.IntervalMonth:
+		start = start.AddDate(0, 0, 1-start.Day())
+		months = 1
+	case domain.IntervalQuarter:
+		start = time.Date(start.Year(), start.Month()-(start.Month()-1)%3, 1, 0, 0, 0, 0, start.Location())
+		months = 3
+	case domain.IntervalYear:
+		start = time.Date(start.Year(), 1, 1, 0, 0, 0, 0, start.Location())
+		years = 1
+	}
+
+	count := 0
+	for period := start; !period.After(to) && count <= domain.MaxGroupedPeriods; period = period.AddDate(years, months, days) {
+		count++
+	}
+	return count
+}
+
+// GetStreaks returns the longest runs of consecutive days with and without outcomes
+// between from and to, days being taken in loc. Ties keep the earliest run. The
+// range may span at most domain.MaxStreakDays days.
+func (s *OutcomeService) GetStreaks(ctx context.Context, from time.Time, to time.Time, loc *time.Location, userId int) (*domain.SpendingStreaks, error) {
+	if from.After(to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = *clampFutureTo(&to, s.validation.ClampFutureTo)
+
+	if dayNumber(to.In(loc))-dayNumber(from.In(loc)) >= domain.MaxStreakDays {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: fmt.Errorf("range must span at most %d days", domain.MaxStreakDays),
+		}
+	}
+
+	sums, err := s.repo.GetDailySums(ctx, &from, &to, loc.String(), userId)
+	if err != nil {
+		return nil, err
+	}
+
+	spent := make(map[string]bool, len(sums))
+	for _, sum := range sums {
+		if sum.Total > 0 {
+			spent[sum.Day] = true
+		}
+	}
+
+	streaks := &domain.SpendingStreaks{}
+	var current domain.Streak
+	currentSpending := false
+
+	from = from.In(loc)
+	to = to.In(loc)
+	firstDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	lastDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc)
+	for day := firstDay; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		key := day.Format(time.DateOnly)
+		if current.Days == 0 || spent[key] != currentSpending {
+			current = domain.Streak{From: key}
+			currentSpending = spent[key]
+		}
+		current.Days++
+		current.To = key
+
+		longest := &streaks.LongestNoSpend
+		if currentSpending {
+			longest = &streaks.LongestSpending
+		}
+		if current.Days > longest.Days {
+			*longest = current
+		}
+	}
+
+	return streaks, nil
+}
+
 func (s *OutcomeService) GetTotal(ctx context.Context, from *time.Time, to *time.Time, userId int) (int, error) {
 	if from != nil && to != nil && from.After(*to) {
 		return 0, &domain.InvalidDateError{
@@ -218,6 +742,8 @@ func (s *OutcomeService) GetTotal(ctx context.Context, from *time.Time, to *time
 		}
 	}
 
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
 	return s.repo.GetTotalSum(ctx, from, to, userId)
 }
 
@@ -228,6 +754,8 @@ func (s *OutcomeService) GetSeries(ctx context.Context, from *time.Time, to *tim
 		}
 	}
 
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
 	return s.repo.GetMonthlySeries(ctx, from, to, userId)
 }
 
@@ -238,5 +766,185 @@ func (s *OutcomeService) GetTotalSeries(ctx context.Context, from *time.Time, to
 		}
 	}
 
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
 	return s.repo.GetMonthlyTotalSeries(ctx, from, to, userId)
 }
+
+func (s *OutcomeService) GetMonthlyCounts(ctx context.Context, from *time.Time, to *time.Time, userId int) ([]domain.MonthlyCount, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	to = clampFutureTo(to, s.validation.ClampFutureTo)
+
+	return s.repo.GetMonthlyCounts(ctx, from, to, userId)
+}
+
+// GetNewCategories returns the categories with spending during the given month
+// but none during the previous one. Uncategorized outcomes are not a category.
+func (s *OutcomeService) GetNewCategories(ctx context.Context, month time.Time, userId int) ([]domain.CategorySum, error) {
+	from, to := monthBounds(month)
+	previousFrom, previousTo := monthBounds(from.AddDate(0, -1, 0))
+
+	current, err := s.repo.GetSumByCategory(ctx, &from, &to, 0, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := s.repo.GetSumByCategory(ctx, &previousFrom, &previousTo, 0, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	spentPreviously := make(map[int]bool)
+	for _, p := range previous {
+		if p.Total > 0 {
+			spentPreviously[p.CategoryId] = true
+		}
+	}
+
+	newCategories := []domain.CategorySum{}
+	for _, c := range current {
+		if c.CategoryId != 0 && c.Total > 0 && !spentPreviously[c.CategoryId] {
+			newCategories = append(newCategories, c)
+		}
+	}
+
+	return newCategories, nil
+}
+
+// GetRollingTotal returns the total of outcomes over the trailing window of the
+// given number of days ending at now.
+func (s *OutcomeService) GetRollingTotal(ctx context.Context, now time.Time, days int, userId int) (*domain.RollingTotal, error) {
+	if days <= 0 || days > domain.MaxRollingWindowDays {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: fmt.Errorf("window must be between 1 and %d days", domain.MaxRollingWindowDays),
+		}
+	}
+
+	from := now.AddDate(0, 0, -days)
+
+	total, err := s.repo.GetTotalSum(ctx, &from, &now, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.RollingTotal{
+		From:  from,
+		To:    now,
+		Total: total,
+	}, nil
+}
+
+// GetYearOverYear compares the outcomes total of the given month with the one of
+// the same month a year earlier.
+func (s *OutcomeService) GetYearOverYear(ctx context.Context, month time.Time, userId int) (*domain.YearOverYear, error) {
+	from, to := monthBounds(month)
+	previousFrom, previousTo := monthBounds(from.AddDate(-1, 0, 0))
+
+	total, err := s.GetTotal(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	previousTotal, err := s.GetTotal(ctx, &previousFrom, &previousTo, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.YearOverYear{
+		Month:         from.Format("2006-01"),
+		Total:         total,
+		PreviousMonth: previousFrom.Format("2006-01"),
+		PreviousTotal: previousTotal,
+		Delta:         total - previousTotal,
+		Percent:       percentOf(total-previousTotal, previousTotal),
+	}, nil
+}
+
+// GetProjection linearly projects the month-end total of outcomes from the
+// spending between the start of the month and now.
+func (s *OutcomeService) GetProjection(ctx context.Context, now time.Time, userId int) (*domain.SpendingProjection, error) {
+	from, to := monthBounds(now)
+
+	spent, err := s.repo.GetTotalSum(ctx, &from, &now, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The current day counts as elapsed, so there is at least one day to divide
+	// by, even on the first day of the month
+	daysElapsed := now.Day()
+	daysInMonth := to.Day()
+
+	return &domain.SpendingProjection{
+		SpentSoFar:     spent,
+		DaysElapsed:    daysElapsed,
+		DaysInMonth:    daysInMonth,
+		ProjectedTotal: spent * daysInMonth / daysElapsed,
+	}, nil
+}
+
+// GetForecast predicts the outcomes of the months following now. The model is
+// deliberately simple: each category is forecast at its average over the last
+// ForecastHistoryMonths complete months, months without outcomes counting as zero,
+// so every forecast month carries the same amounts. Uncategorized outcomes are
+// forecast under the category id 0.
+func (s *OutcomeService) GetForecast(ctx context.Context, now time.Time, months int, userId int) ([]domain.MonthlyForecast, error) {
+	if months <= 0 || months > domain.MaxForecastMonths {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: fmt.Errorf("months must be between 1 and %d", domain.MaxForecastMonths),
+		}
+	}
+
+	currentMonth, _ := monthBounds(now)
+	from := currentMonth.AddDate(0, -domain.ForecastHistoryMonths, 0)
+	to := currentMonth.Add(-time.Nanosecond)
+
+	history, err := s.repo.GetMonthlySeries(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[int]int)
+	for _, month := range history {
+		for categoryId, total := range month.Categories {
+			totals[categoryId] += total
+		}
+	}
+
+	averages := make(map[int]int, len(totals))
+	averagesTotal := 0
+	for categoryId, total := range totals {
+		average := int(math.Round(float64(total) / float64(domain.ForecastHistoryMonths)))
+		averages[categoryId] = average
+		averagesTotal += average
+	}
+
+	forecast := make([]domain.MonthlyForecast, 0, months)
+	for i := 1; i <= months; i++ {
+		categories := make(map[int]int, len(averages))
+		maps.Copy(categories, averages)
+		forecast = append(forecast, domain.MonthlyForecast{
+			Month:      currentMonth.AddDate(0, i, 0).Format("2006-01"),
+			Categories: categories,
+			Total:      averagesTotal,
+		})
+	}
+
+	return forecast, nil
+}
+
+// monthBounds returns the first and last instants of the month containing t.
+func monthBounds(t time.Time) (time.Time, time.Time) {
+	from := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	to := from.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	return from, to
+}
+
+func (s *OutcomeService) GetDateBounds(ctx context.Context, userId int) (*domain.DateBounds, error) {
+	return s.repo.GetDateBounds(ctx, userId)
+}
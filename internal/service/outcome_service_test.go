@@ -3,10 +3,13 @@ package service
 import (
 	"context"
 	"errors"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/kerhael/accounting/internal/config"
 	"github.com/kerhael/accounting/internal/domain"
 	"github.com/kerhael/accounting/internal/infrastructure/repository/mocks"
 	"github.com/stretchr/testify/assert"
@@ -16,7 +19,7 @@ import (
 func TestCreateOutcome_Success(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -26,6 +29,7 @@ func TestCreateOutcome_Success(t *testing.T) {
 		UserId: userId,
 	}
 	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
 
 	name := "Restaurant"
 	amount := 1999
@@ -51,10 +55,78 @@ func TestCreateOutcome_Success(t *testing.T) {
 	mockCategoryRepo.AssertExpectations(t)
 }
 
+func TestCreateOutcome_AllowedCategory(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	category := &domain.Category{ID: 2, Label: "Food", UserId: userId}
+	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return([]int{1, 2}, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil)
+
+	createdAt := time.Now()
+	outcome, err := service.Create(ctx, "Restaurant", 1999, category.ID, &createdAt, userId)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, outcome)
+	assert.Equal(t, category.ID, outcome.CategoryId)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestCreateOutcome_DisallowedCategory(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	category := &domain.Category{ID: 3, Label: "Leisure", UserId: userId}
+	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return([]int{1, 2}, nil)
+
+	createdAt := time.Now()
+	outcome, err := service.Create(ctx, "Cinema", 1200, category.ID, &createdAt, userId)
+
+	assert.Error(t, err)
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.Contains(t, err.Error(), "category not allowed for this user")
+
+	mockRepo.AssertNotCalled(t, "Create")
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestCreateOutcome_RestrictedUncategorized(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	mockCategoryRepo.On("FindDefault", ctx, userId).Return((*domain.Category)(nil), pgx.ErrNoRows)
+	mockCategoryRepo.On("IsCategoryRequired", ctx, userId).Return(false, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return([]int{1, 2}, nil)
+
+	createdAt := time.Now()
+	outcome, err := service.Create(ctx, "Cinema", 1200, 0, &createdAt, userId)
+
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.Contains(t, err.Error(), "category is required for this user")
+
+	mockRepo.AssertNotCalled(t, "Create")
+	mockCategoryRepo.AssertExpectations(t)
+}
+
 func TestCreateOutcome_InvalidName(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -64,6 +136,7 @@ func TestCreateOutcome_InvalidName(t *testing.T) {
 		UserId: userId,
 	}
 	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
 
 	name := ""
 	amount := 100
@@ -80,7 +153,7 @@ func TestCreateOutcome_InvalidName(t *testing.T) {
 func TestCreateOutcome_InvalidName_Whitespace(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -90,6 +163,7 @@ func TestCreateOutcome_InvalidName_Whitespace(t *testing.T) {
 		UserId: userId,
 	}
 	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
 
 	name := "   "
 	amount := 100
@@ -106,7 +180,7 @@ func TestCreateOutcome_InvalidName_Whitespace(t *testing.T) {
 func TestCreateOutcome_InvalidAmount_Zero(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -116,6 +190,7 @@ func TestCreateOutcome_InvalidAmount_Zero(t *testing.T) {
 		UserId: userId,
 	}
 	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
 
 	name := "Restaurant"
 	amount := 0
@@ -132,7 +207,7 @@ func TestCreateOutcome_InvalidAmount_Zero(t *testing.T) {
 func TestCreateOutcome_InvalidAmount_Negative(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -142,6 +217,7 @@ func TestCreateOutcome_InvalidAmount_Negative(t *testing.T) {
 		UserId: userId,
 	}
 	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
 
 	name := "Restaurant"
 	amount := -1
@@ -155,28 +231,80 @@ func TestCreateOutcome_InvalidAmount_Negative(t *testing.T) {
 	assert.IsType(t, &domain.InvalidEntityError{}, err)
 }
 
-func TestCreateOutcome_InvalidCategoryId(t *testing.T) {
+func TestCreateOutcome_NoCategory_UsesDefault(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
-	name := "Restaurant"
-	amount := 1999
-	categoryId := 0
+	userId := 123
+	defaultCategory := &domain.Category{ID: 7, Label: "Misc", UserId: userId}
+	mockCategoryRepo.On("FindDefault", ctx, userId).Return(defaultCategory, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(o *domain.Outcome) bool {
+		return o.CategoryId == 7 && o.UserId == userId
+	})).Return(nil)
+
 	createdAt := time.Now()
+	outcome, err := service.Create(ctx, "Coffee", 350, 0, &createdAt, userId)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, outcome)
+	assert.Equal(t, 7, outcome.CategoryId)
+
+	mockCategoryRepo.AssertNotCalled(t, "FindById", mock.Anything, mock.Anything, mock.Anything)
+	mockCategoryRepo.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateOutcome_NoCategory_NoDefaultSet(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockCategoryRepo.On("FindDefault", ctx, 123).Return((*domain.Category)(nil), pgx.ErrNoRows)
+	mockCategoryRepo.On("IsCategoryRequired", ctx, 123).Return(true, nil)
 
-	outcome, err := service.Create(ctx, name, amount, categoryId, &createdAt, 123)
+	createdAt := time.Now()
+	outcome, err := service.Create(ctx, "Restaurant", 1999, 0, &createdAt, 123)
 
 	assert.Error(t, err)
 	assert.Nil(t, outcome)
 	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.Contains(t, err.Error(), "category is required")
+
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestCreateOutcome_NoCategory_CategoryOptional(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockCategoryRepo.On("FindDefault", ctx, 123).Return((*domain.Category)(nil), pgx.ErrNoRows)
+	mockCategoryRepo.On("IsCategoryRequired", ctx, 123).Return(false, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, 123).Return(nil, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(o *domain.Outcome) bool {
+		return o.CategoryId == 0 && o.UserId == 123
+	})).Return(nil)
+
+	createdAt := time.Now()
+	outcome, err := service.Create(ctx, "Restaurant", 1999, 0, &createdAt, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, outcome.CategoryId)
+
+	mockCategoryRepo.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
 }
 
 func TestCreateOutcome_CategoryNotFound(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	categoryId := 1
@@ -199,7 +327,7 @@ func TestCreateOutcome_CategoryNotFound(t *testing.T) {
 func TestCreateOutcome_InvalidCreatedAt(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -209,6 +337,7 @@ func TestCreateOutcome_InvalidCreatedAt(t *testing.T) {
 		UserId: userId,
 	}
 	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
 
 	name := "Restaurant"
 	amount := 1999
@@ -227,7 +356,7 @@ func TestCreateOutcome_InvalidCreatedAt(t *testing.T) {
 func TestCreateOutcome_RepoError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -237,6 +366,7 @@ func TestCreateOutcome_RepoError(t *testing.T) {
 		UserId: userId,
 	}
 	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
 
 	name := "Restaurant"
 	amount := 1999
@@ -258,7 +388,7 @@ func TestCreateOutcome_RepoError(t *testing.T) {
 func TestGetAllOutcomes_Success(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -297,10 +427,61 @@ func TestGetAllOutcomes_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestGetAllOutcomesAfter_ReturnsNextCursor(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	t1 := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	t3 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	after := &domain.Cursor{CreatedAt: time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC), ID: 9}
+	mockRepo.On("FindAllAfter", ctx, (*time.Time)(nil), (*time.Time)(nil), 0, userId, after, 3).Return([]domain.Outcome{
+		{ID: 8, CreatedAt: &t1},
+		{ID: 7, CreatedAt: &t2},
+		{ID: 6, CreatedAt: &t3},
+	}, nil)
+	mockRepo.On("CountAll", ctx, (*time.Time)(nil), (*time.Time)(nil), 0, userId).Return(10, nil)
+
+	outcomes, next, total, err := service.GetAllAfter(ctx, nil, nil, 0, userId, after, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, outcomes, 2)
+	assert.Equal(t, &domain.Cursor{CreatedAt: t2, ID: 7}, next)
+	assert.Equal(t, 10, total)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetAllOutcomesAfter_LastPage(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	after := &domain.Cursor{CreatedAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), ID: 7}
+	mockRepo.On("FindAllAfter", ctx, (*time.Time)(nil), (*time.Time)(nil), 0, userId, after, 3).Return([]domain.Outcome{
+		{ID: 6, CreatedAt: &t1},
+	}, nil)
+	mockRepo.On("CountAll", ctx, (*time.Time)(nil), (*time.Time)(nil), 0, userId).Return(3, nil)
+
+	outcomes, next, _, err := service.GetAllAfter(ctx, nil, nil, 0, userId, after, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, outcomes, 1)
+	assert.Nil(t, next)
+
+	mockRepo.AssertExpectations(t)
+}
+
 func TestGetAllOutcomes_InvalidDates(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	to := time.Now()
@@ -320,7 +501,7 @@ func TestGetAllOutcomes_InvalidDates(t *testing.T) {
 func TestGetAllOutcomes_CategoryNotFound(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	categoryId := 1
@@ -341,7 +522,7 @@ func TestGetAllOutcomes_CategoryNotFound(t *testing.T) {
 func TestGetAllOutcomes_EmptyList(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	expectedOutcomes := []domain.Outcome{}
@@ -362,7 +543,7 @@ func TestGetAllOutcomes_EmptyList(t *testing.T) {
 func TestGetAllOutcomes_RepoError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -381,7 +562,7 @@ func TestGetAllOutcomes_RepoError(t *testing.T) {
 func TestGetAllOutcomes_CountError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -411,7 +592,7 @@ func TestGetAllOutcomes_CountError(t *testing.T) {
 func TestGetById_Success(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -442,7 +623,7 @@ func TestGetById_Success(t *testing.T) {
 func TestGetById_InvalidId_Zero(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	outcome, err := service.GetById(ctx, 0, 123)
@@ -457,7 +638,7 @@ func TestGetById_InvalidId_Zero(t *testing.T) {
 func TestGetById_InvalidId_Negative(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	outcome, err := service.GetById(ctx, -1, 123)
@@ -472,7 +653,7 @@ func TestGetById_InvalidId_Negative(t *testing.T) {
 func TestGetById_NotFound(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	mockRepo.On("FindById", ctx, 999, 123).Return((*domain.Outcome)(nil), pgx.ErrNoRows)
@@ -489,7 +670,7 @@ func TestGetById_NotFound(t *testing.T) {
 func TestGetById_RepoError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	repoErr := errors.New("repo error")
@@ -507,7 +688,7 @@ func TestGetById_RepoError(t *testing.T) {
 func TestPatchById_Success_NameOnly(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -548,7 +729,7 @@ func TestPatchById_Success_NameOnly(t *testing.T) {
 func TestPatchById_Success_AllFields(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -568,6 +749,7 @@ func TestPatchById_Success_AllFields(t *testing.T) {
 		UserId: userId,
 	}
 	mockCategoryRepo.On("FindById", ctx, 2, userId).Return(newCategory, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
 
 	newCreatedAt := time.Now()
 	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil).Run(func(args mock.Arguments) {
@@ -597,7 +779,7 @@ func TestPatchById_Success_AllFields(t *testing.T) {
 func TestPatchById_InvalidCategory(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -623,10 +805,42 @@ func TestPatchById_InvalidCategory(t *testing.T) {
 	mockCategoryRepo.AssertExpectations(t)
 }
 
+func TestPatchById_DisallowedCategory(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	existingOutcome := &domain.Outcome{
+		ID:         1,
+		Name:       "Old Name",
+		Amount:     1000,
+		CategoryId: 1,
+		CreatedAt:  &time.Time{},
+		UserId:     userId,
+	}
+	mockRepo.On("FindById", ctx, 1, userId).Return(existingOutcome, nil)
+
+	category := &domain.Category{ID: 3, Label: "Leisure", UserId: userId}
+	mockCategoryRepo.On("FindById", ctx, category.ID, userId).Return(category, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return([]int{1, 2}, nil)
+
+	outcome, err := service.PatchById(ctx, 1, "", 0, category.ID, nil, userId)
+
+	assert.Error(t, err)
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+
+	mockRepo.AssertNotCalled(t, "Update")
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
 func TestPatchById_NotFound(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -642,10 +856,94 @@ func TestPatchById_NotFound(t *testing.T) {
 	mockCategoryRepo.AssertNotCalled(t, "FindById")
 }
 
+func TestPatchById_Uncategorized_CategoryRequired(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	uncategorized := &domain.Outcome{ID: 1, Name: "Cash", Amount: 2000, CreatedAt: &time.Time{}, UserId: userId}
+	mockRepo.On("FindById", ctx, 1, userId).Return(uncategorized, nil)
+	mockCategoryRepo.On("IsCategoryRequired", ctx, userId).Return(true, nil)
+
+	outcome, err := service.PatchById(ctx, 1, "ATM cash", 0, 0, nil, userId)
+
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.Contains(t, err.Error(), "category is required")
+
+	mockRepo.AssertNotCalled(t, "Update")
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestPatchById_Uncategorized_CategoryOptional(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	uncategorized := &domain.Outcome{ID: 1, Name: "Cash", Amount: 2000, CreatedAt: &time.Time{}, UserId: userId}
+	mockRepo.On("FindById", ctx, 1, userId).Return(uncategorized, nil)
+	mockCategoryRepo.On("IsCategoryRequired", ctx, userId).Return(false, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return(nil, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil)
+
+	outcome, err := service.PatchById(ctx, 1, "ATM cash", 0, 0, nil, userId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ATM cash", outcome.Name)
+	assert.Equal(t, 0, outcome.CategoryId)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestPatchById_Uncategorized_Restricted(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	uncategorized := &domain.Outcome{ID: 1, Name: "Cash", Amount: 2000, CreatedAt: &time.Time{}, UserId: userId}
+	mockRepo.On("FindById", ctx, 1, userId).Return(uncategorized, nil)
+	mockCategoryRepo.On("IsCategoryRequired", ctx, userId).Return(false, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, userId).Return([]int{1, 2}, nil)
+
+	outcome, err := service.PatchById(ctx, 1, "ATM cash", 0, 0, nil, userId)
+
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestPatchById_OtherUsersOutcome(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	otherUsersOutcome := &domain.Outcome{ID: 1, Name: "Rent", Amount: 90000, CategoryId: 1, CreatedAt: &time.Time{}, UserId: 456}
+	mockRepo.On("FindById", ctx, 1, userId).Return(otherUsersOutcome, nil)
+
+	outcome, err := service.PatchById(ctx, 1, "Mine now", 0, 0, nil, userId)
+
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.EntityNotFoundError{}, err)
+
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
 func TestPatchById_UpdateError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -674,11 +972,11 @@ func TestPatchById_UpdateError(t *testing.T) {
 func TestOutcomeDeleteById_Success(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
-	mockRepo.On("DeleteById", ctx, 1, userId).Return(nil)
+	mockRepo.On("DeleteById", ctx, 1, userId).Return(int64(1), nil)
 
 	err := service.DeleteById(ctx, 1, userId)
 
@@ -688,10 +986,28 @@ func TestOutcomeDeleteById_Success(t *testing.T) {
 	mockCategoryRepo.AssertNotCalled(t, "FindById")
 }
 
+func TestOutcomeDeleteById_NotFound(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	mockRepo.On("DeleteById", ctx, 99, userId).Return(int64(0), nil)
+
+	err := service.DeleteById(ctx, 99, userId)
+
+	assert.Error(t, err)
+	assert.IsType(t, &domain.EntityNotFoundError{}, err)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertNotCalled(t, "FindById")
+}
+
 func TestOutcomeDeleteById_InvalidId_Zero(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	err := service.DeleteById(ctx, 0, 123)
@@ -706,7 +1022,7 @@ func TestOutcomeDeleteById_InvalidId_Zero(t *testing.T) {
 func TestOutcomeDeleteById_InvalidId_Negative(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	err := service.DeleteById(ctx, -1, 123)
@@ -721,12 +1037,12 @@ func TestOutcomeDeleteById_InvalidId_Negative(t *testing.T) {
 func TestOutcomeDeleteById_RepoError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
 	repoErr := errors.New("repo error")
-	mockRepo.On("DeleteById", ctx, 1, userId).Return(repoErr)
+	mockRepo.On("DeleteById", ctx, 1, userId).Return(int64(0), repoErr)
 
 	err := service.DeleteById(ctx, 1, userId)
 
@@ -737,92 +1053,254 @@ func TestOutcomeDeleteById_RepoError(t *testing.T) {
 	mockCategoryRepo.AssertNotCalled(t, "FindById")
 }
 
-func TestGetSum_Success_NoFilters(t *testing.T) {
+func freezeValidationConfig() config.ValidationConfig {
+	validation := config.DefaultValidationConfig()
+	freezeDate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	validation.FreezeDate = &freezeDate
+	return validation
+}
+
+func TestPatchById_BeforeFreezeDate(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, freezeValidationConfig())
 	ctx := context.Background()
 
-	categorySums := []domain.CategorySum{
-		{CategoryId: 1, Total: 3000},
-		{CategoryId: 2, Total: 1500},
-	}
-	mockRepo.On("GetSumByCategory", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, 123).Return(categorySums, nil)
-
-	result, err := service.GetSum(ctx, nil, nil, 0, 123)
+	createdAt := time.Date(2025, 12, 31, 23, 59, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, Name: "Rent", Amount: 1000, CategoryId: 1, CreatedAt: &createdAt, UserId: 123}, nil)
 
-	assert.NoError(t, err)
-	assert.Len(t, result, 2)
-	assert.Equal(t, 1, result[0].CategoryId)
-	assert.Equal(t, 3000, result[0].Total)
-	assert.Equal(t, 2, result[1].CategoryId)
-	assert.Equal(t, 1500, result[1].Total)
+	outcome, err := service.PatchById(ctx, 1, "New Name", 0, 0, nil, 123)
 
-	mockRepo.AssertExpectations(t)
-	mockCategoryRepo.AssertNotCalled(t, "FindById")
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.ErrorContains(t, err, "records created before 2026-01-01 are frozen")
+	mockRepo.AssertNotCalled(t, "Update")
 }
 
-func TestGetSum_Success_WithFilters(t *testing.T) {
+func TestPatchById_MoveBeforeFreezeDate(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, freezeValidationConfig())
 	ctx := context.Background()
 
-	userId := 123
-	category := &domain.Category{ID: 1, Label: "Food", UserId: userId}
-	mockCategoryRepo.On("FindById", ctx, 1, userId).Return(category, nil)
+	createdAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, Name: "Rent", Amount: 1000, CategoryId: 1, CreatedAt: &createdAt, UserId: 123}, nil)
 
-	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newCreatedAt := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	outcome, err := service.PatchById(ctx, 1, "", 0, 0, &newCreatedAt, 123)
 
-	categorySums := []domain.CategorySum{
-		{CategoryId: 1, Total: 3000},
-	}
-	mockRepo.On("GetSumByCategory", ctx, &from, &to, 1, userId).Return(categorySums, nil)
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Update")
+}
 
-	result, err := service.GetSum(ctx, &from, &to, 1, userId)
+func TestPatchById_AfterFreezeDate(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, freezeValidationConfig())
+	ctx := context.Background()
 
-	assert.NoError(t, err)
-	assert.Len(t, result, 1)
-	assert.Equal(t, 1, result[0].CategoryId)
-	assert.Equal(t, 3000, result[0].Total)
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, Name: "Rent", Amount: 1000, CategoryId: 1, CreatedAt: &createdAt, UserId: 123}, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil)
+
+	outcome, err := service.PatchById(ctx, 1, "New Name", 0, 0, nil, 123)
 
+	assert.NoError(t, err)
+	assert.Equal(t, "New Name", outcome.Name)
 	mockRepo.AssertExpectations(t)
-	mockCategoryRepo.AssertExpectations(t)
 }
 
-func TestGetSum_InvalidDates(t *testing.T) {
+func TestOutcomeDeleteById_BeforeFreezeDate(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, freezeValidationConfig())
 	ctx := context.Background()
 
-	to := time.Now()
-	from := to.Add(24 * time.Hour)
-
-	result, err := service.GetSum(ctx, &from, &to, 0, 123)
+	createdAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, CreatedAt: &createdAt, UserId: 123}, nil)
 
-	assert.Error(t, err)
-	assert.Nil(t, result)
-	assert.IsType(t, &domain.InvalidDateError{}, err)
+	err := service.DeleteById(ctx, 1, 123)
 
-	mockRepo.AssertNotCalled(t, "GetSumByCategory")
-	mockCategoryRepo.AssertNotCalled(t, "FindById")
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "DeleteById", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestGetSum_InvalidCategory(t *testing.T) {
+func TestOutcomeDeleteById_AfterFreezeDate(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, freezeValidationConfig())
 	ctx := context.Background()
 
-	mockCategoryRepo.On("FindById", ctx, 999, 123).Return((*domain.Category)(nil), errors.New("not found"))
+	createdAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, CreatedAt: &createdAt, UserId: 123}, nil)
+	mockRepo.On("DeleteById", ctx, 1, 123).Return(int64(1), nil)
 
-	result, err := service.GetSum(ctx, nil, nil, 999, 123)
+	err := service.DeleteById(ctx, 1, 123)
 
-	assert.Error(t, err)
-	assert.Nil(t, result)
-	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestOutcomeDeleteById_FreezeDateNotFound(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, freezeValidationConfig())
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 99, 123).Return((*domain.Outcome)(nil), pgx.ErrNoRows)
+
+	err := service.DeleteById(ctx, 99, 123)
+
+	assert.IsType(t, &domain.EntityNotFoundError{}, err)
+	mockRepo.AssertNotCalled(t, "DeleteById", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExport_PagesThroughOutcomes(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockCategoryRepo.On("FindAll", ctx, userId).Return([]domain.Category{{ID: 1, Label: "Food", UserId: userId}}, nil)
+
+	firstPage := make([]domain.Outcome, exportPageSize)
+	for i := range firstPage {
+		createdAt := to.Add(-time.Duration(i+1) * time.Minute)
+		firstPage[i] = domain.Outcome{ID: exportPageSize + 1 - i, Amount: 100, CategoryId: 1, CreatedAt: &createdAt, UserId: userId}
+	}
+	last := firstPage[exportPageSize-1]
+	secondPage := []domain.Outcome{{ID: 1, Amount: 200, CategoryId: 1, CreatedAt: &from, UserId: userId}}
+
+	mockRepo.On("FindAllAfter", ctx, &from, &to, 0, userId, (*domain.Cursor)(nil), exportPageSize).Return(firstPage, nil)
+	mockRepo.On("FindAllAfter", ctx, &from, &to, 0, userId, &domain.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}, exportPageSize).Return(secondPage, nil)
+
+	exported, err := service.Export(ctx, &from, &to, 0, userId)
+
+	assert.NoError(t, err)
+	assert.Len(t, exported, exportPageSize+1)
+	assert.Equal(t, "Food", exported[0].CategoryLabel)
+	assert.Equal(t, 1, exported[exportPageSize].ID)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestExport_TooManyOutcomes(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockCategoryRepo.On("FindAll", ctx, userId).Return([]domain.Category{}, nil)
+
+	// Every page is full, so the export never ends before the cap
+	page := make([]domain.Outcome, exportPageSize)
+	for i := range page {
+		page[i] = domain.Outcome{ID: i + 1, Amount: 100, CreatedAt: &from, UserId: userId}
+	}
+	mockRepo.On("FindAllAfter", ctx, &from, &to, 0, userId, mock.Anything, exportPageSize).Return(page, nil)
+
+	exported, err := service.Export(ctx, &from, &to, 0, userId)
+
+	assert.Nil(t, exported)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+	assert.EqualError(t, err, "invalid date range: cannot export more than 50000 outcomes, narrow the date range")
+	mockRepo.AssertNumberOfCalls(t, "FindAllAfter", domain.MaxExportedOutcomes/exportPageSize+1)
+}
+
+func TestGetSum_Success_NoFilters(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	categorySums := []domain.CategorySum{
+		{CategoryId: 1, Total: 3000},
+		{CategoryId: 2, Total: 1500},
+	}
+	mockRepo.On("GetSumByCategory", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, 123).Return(categorySums, nil)
+
+	result, err := service.GetSum(ctx, nil, nil, 0, 123)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, 1, result[0].CategoryId)
+	assert.Equal(t, 3000, result[0].Total)
+	assert.Equal(t, 2, result[1].CategoryId)
+	assert.Equal(t, 1500, result[1].Total)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertNotCalled(t, "FindById")
+}
+
+func TestGetSum_Success_WithFilters(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	userId := 123
+	category := &domain.Category{ID: 1, Label: "Food", UserId: userId}
+	mockCategoryRepo.On("FindById", ctx, 1, userId).Return(category, nil)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	categorySums := []domain.CategorySum{
+		{CategoryId: 1, Total: 3000},
+	}
+	mockRepo.On("GetSumByCategory", ctx, &from, &to, 1, userId).Return(categorySums, nil)
+
+	result, err := service.GetSum(ctx, &from, &to, 1, userId)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, 1, result[0].CategoryId)
+	assert.Equal(t, 3000, result[0].Total)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestGetSum_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
+
+	result, err := service.GetSum(ctx, &from, &to, 0, 123)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+
+	mockRepo.AssertNotCalled(t, "GetSumByCategory")
+	mockCategoryRepo.AssertNotCalled(t, "FindById")
+}
+
+func TestGetSum_InvalidCategory(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockCategoryRepo.On("FindById", ctx, 999, 123).Return((*domain.Category)(nil), errors.New("not found"))
+
+	result, err := service.GetSum(ctx, nil, nil, 999, 123)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
 
 	mockRepo.AssertNotCalled(t, "GetSumByCategory")
 	mockCategoryRepo.AssertExpectations(t)
@@ -831,7 +1309,7 @@ func TestGetSum_InvalidCategory(t *testing.T) {
 func TestGetSum_EmptyList(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	categorySums := []domain.CategorySum{}
@@ -842,32 +1320,488 @@ func TestGetSum_EmptyList(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 
-	mockRepo.AssertExpectations(t)
-	mockCategoryRepo.AssertNotCalled(t, "FindById")
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertNotCalled(t, "FindById")
+}
+
+func TestGetSum_RepoError(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockRepo.On("GetSumByCategory", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, 123).Return([]domain.CategorySum(nil), errors.New("repo error"))
+
+	result, err := service.GetSum(ctx, nil, nil, 0, 123)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "repo error", err.Error())
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertNotCalled(t, "FindById")
+}
+
+func TestGetAverageByCategory_Success(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	expected := []domain.CategoryAverage{
+		{CategoryId: 1, Average: 250, Count: 8},
+		{CategoryId: 2, Average: 0, Count: 0},
+	}
+	mockRepo.On("GetAverageByCategory", ctx, &from, &to, 123).Return(expected, nil)
+
+	result, err := service.GetAverageByCategory(ctx, &from, &to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetAverageByCategory_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
+
+	result, err := service.GetAverageByCategory(ctx, &from, &to, 123)
+
+	assert.Nil(t, result)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+
+	mockRepo.AssertNotCalled(t, "GetAverageByCategory")
+}
+
+func TestGetOutliers_Success(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	expected := []domain.OutcomeOutlier{
+		{Outcome: domain.Outcome{ID: 9, Amount: 89900, CategoryId: 3}, CategoryAverage: 12000},
+	}
+	mockRepo.On("FindOutliers", ctx, &from, &to, 2.0, 123).Return(expected, nil)
+
+	result, err := service.GetOutliers(ctx, &from, &to, 2, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetOutliers_FactorTooSmall(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	result, err := service.GetOutliers(ctx, &from, &to, 0.5, 123)
+
+	assert.Nil(t, result)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+
+	mockRepo.AssertNotCalled(t, "FindOutliers")
+}
+
+func TestGetOutliers_NonFiniteFactor(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+
+	for _, factor := range []float64{math.NaN(), math.Inf(1)} {
+		result, err := service.GetOutliers(context.Background(), nil, nil, factor, 123)
+
+		assert.Nil(t, result)
+		assert.IsType(t, &domain.InvalidEntityError{}, err)
+	}
+	mockRepo.AssertNotCalled(t, "FindOutliers")
+}
+
+func TestGetOutliers_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
+
+	result, err := service.GetOutliers(ctx, &from, &to, 2, 123)
+
+	assert.Nil(t, result)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+
+	mockRepo.AssertNotCalled(t, "FindOutliers")
+}
+
+func TestGetFrequentNames_Success(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	expected := []domain.NameFrequency{{Name: "Bakery", Count: 12, Total: 3600}}
+	mockRepo.On("FindFrequentNames", ctx, &from, &to, 123, 10).Return(expected, nil)
+
+	result, err := service.GetFrequentNames(ctx, &from, &to, 123, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetFrequentNames_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
+
+	result, err := service.GetFrequentNames(context.Background(), &from, &to, 123, 10)
+
+	assert.Nil(t, result)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+
+	mockRepo.AssertNotCalled(t, "FindFrequentNames")
+}
+
+func TestGetHistogram_ClassifiesAmounts(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("GetAmountCounts", ctx, &from, &to, 123).Return([]domain.AmountCount{
+		{Amount: 50, Count: 1},
+		{Amount: 100, Count: 2},
+		{Amount: 999, Count: 1},
+		{Amount: 1000, Count: 3},
+		{Amount: 4999, Count: 1},
+		{Amount: 25000, Count: 2},
+	}, nil)
+
+	buckets, err := service.GetHistogram(ctx, &from, &to, []int{100, 1000, 5000}, 123)
+
+	assert.NoError(t, err)
+	upper1000, upper5000 := 1000, 5000
+	assert.Equal(t, []domain.AmountBucket{
+		{Min: 100, Max: &upper1000, Count: 3, Total: 1199},
+		{Min: 1000, Max: &upper5000, Count: 4, Total: 7999},
+		{Min: 5000, Count: 2, Total: 50000},
+	}, buckets)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetHistogram_BoundsNotAscending(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+
+	for _, bounds := range [][]int{{0, 5000, 1000}, {0, 1000, 1000}, {-100, 0}, {}} {
+		buckets, err := service.GetHistogram(context.Background(), nil, nil, bounds, 123)
+
+		assert.Nil(t, buckets, "bounds %v", bounds)
+		assert.IsType(t, &domain.InvalidEntityError{}, err, "bounds %v", bounds)
+	}
+
+	mockRepo.AssertNotCalled(t, "GetAmountCounts")
+}
+
+func TestGetForecast_TrailingAverages(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	now := time.Date(2026, 7, 15, 10, 0, 0, 0, time.UTC)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+
+	// Category 1 spends 600 every month, category 2 only twice (900 in total)
+	// and category 3 never
+	mockRepo.On("GetMonthlySeries", ctx, &from, &to, 123).Return([]domain.MonthlySeries{
+		{Month: "2026-01", Categories: map[int]int{1: 600, 2: 0, 3: 0}},
+		{Month: "2026-02", Categories: map[int]int{1: 600, 2: 400, 3: 0}},
+		{Month: "2026-03", Categories: map[int]int{1: 600, 2: 0, 3: 0}},
+		{Month: "2026-04", Categories: map[int]int{1: 600, 2: 0, 3: 0}},
+		{Month: "2026-05", Categories: map[int]int{1: 600, 2: 500, 3: 0}},
+		{Month: "2026-06", Categories: map[int]int{1: 600, 2: 0, 3: 0}},
+	}, nil)
+
+	forecast, err := service.GetForecast(ctx, now, 2, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.MonthlyForecast{
+		{Month: "2026-08", Categories: map[int]int{1: 600, 2: 150, 3: 0}, Total: 750},
+		{Month: "2026-09", Categories: map[int]int{1: 600, 2: 150, 3: 0}, Total: 750},
+	}, forecast)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetForecast_Uncategorized(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	now := time.Date(2026, 7, 15, 10, 0, 0, 0, time.UTC)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+
+	// Uncategorized outcomes come under the category 0 of the series
+	mockRepo.On("GetMonthlySeries", ctx, &from, &to, 123).Return([]domain.MonthlySeries{
+		{Month: "2026-01", Categories: map[int]int{0: 1200, 1: 600}},
+		{Month: "2026-02", Categories: map[int]int{0: 0, 1: 600}},
+		{Month: "2026-03", Categories: map[int]int{0: 0, 1: 600}},
+		{Month: "2026-04", Categories: map[int]int{0: 0, 1: 600}},
+		{Month: "2026-05", Categories: map[int]int{0: 600, 1: 600}},
+		{Month: "2026-06", Categories: map[int]int{0: 0, 1: 600}},
+	}, nil)
+
+	forecast, err := service.GetForecast(ctx, now, 1, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.MonthlyForecast{
+		{Month: "2026-08", Categories: map[int]int{0: 300, 1: 600}, Total: 900},
+	}, forecast)
+}
+
+func TestGetForecast_InvalidMonths(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	for _, months := range []int{0, -1, domain.MaxForecastMonths + 1} {
+		forecast, err := service.GetForecast(ctx, time.Now(), months, 123)
+
+		assert.Nil(t, forecast)
+		assert.IsType(t, &domain.InvalidEntityError{}, err)
+	}
+
+	mockRepo.AssertNotCalled(t, "GetMonthlySeries")
+}
+
+func TestGetGroupedSums_Success(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	loc, _ := time.LoadLocation("Europe/Paris")
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 6, 30, 23, 59, 59, 0, loc)
+	mockRepo.On("GetSumByInterval", ctx, from, to, domain.IntervalQuarter, "Europe/Paris", 123).Return([]domain.PeriodSum{
+		{Start: "2026-01-01", Total: 4500, Count: 3},
+		{Start: "2026-04-01", Total: 0, Count: 0},
+	}, nil)
+
+	sums, err := service.GetGroupedSums(ctx, from, to, domain.IntervalQuarter, loc, 123)
+
+	assert.NoError(t, err)
+	assert.Len(t, sums, 2)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetGroupedSums_InvalidInterval(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+
+	to := time.Now()
+	from := to.AddDate(0, -1, 0)
+
+	for _, interval := range []domain.Interval{"", "hour", "month'); DROP TABLE outcomes; --"} {
+		sums, err := service.GetGroupedSums(context.Background(), from, to, interval, time.UTC, 123)
+
+		assert.Nil(t, sums)
+		assert.IsType(t, &domain.InvalidEntityError{}, err)
+	}
+	mockRepo.AssertNotCalled(t, "GetSumByInterval")
+}
+
+func TestGetGroupedSums_RangeTooLarge(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	sums, err := service.GetGroupedSums(ctx, from, to, domain.IntervalDay, time.UTC, 123)
+
+	assert.Nil(t, sums)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "GetSumByInterval")
+
+	// The same range fits in weeks
+	mockRepo.On("GetSumByInterval", ctx, from, to, domain.IntervalWeek, "UTC", 123).Return([]domain.PeriodSum{}, nil)
+
+	_, err = service.GetGroupedSums(ctx, from, to, domain.IntervalWeek, time.UTC, 123)
+
+	assert.NoError(t, err)
+}
+
+func TestGetGroupedSums_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
+
+	sums, err := service.GetGroupedSums(context.Background(), from, to, domain.IntervalDay, time.UTC, 123)
+
+	assert.Nil(t, sums)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+	mockRepo.AssertNotCalled(t, "GetSumByInterval")
+}
+
+func TestCountPeriods(t *testing.T) {
+	// Wednesday 2026-01-14 to Monday 2026-04-06
+	from := time.Date(2026, 1, 14, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 6, 8, 0, 0, 0, time.UTC)
+
+	for interval, want := range map[domain.Interval]int{
+		domain.IntervalDay:     83,
+		domain.IntervalWeek:    13,
+		domain.IntervalMonth:   4,
+		domain.IntervalQuarter: 2,
+		domain.IntervalYear:    1,
+	} {
+		assert.Equal(t, want, countPeriods(from, to, interval), interval)
+	}
+}
+
+func TestGetStreaks_KnownStreaks(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	loc, _ := time.LoadLocation("Europe/Paris")
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 1, 12, 23, 59, 59, 0, loc)
+
+	// Spending on 1-3, 6-7 and 10: the longest spending run is 1-3, and the
+	// no-spend runs 4-5, 8-9 and 11-12 all last two days so the earliest wins
+	mockRepo.On("GetDailySums", ctx, &from, &to, "Europe/Paris", 123).Return([]domain.DailySum{
+		{Day: "2026-01-01", Total: 1200},
+		{Day: "2026-01-02", Total: 350},
+		{Day: "2026-01-03", Total: 800},
+		{Day: "2026-01-06", Total: 4000},
+		{Day: "2026-01-07", Total: 150},
+		{Day: "2026-01-10", Total: 990},
+	}, nil)
+
+	streaks, err := service.GetStreaks(ctx, from, to, loc, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.SpendingStreaks{
+		LongestSpending: domain.Streak{Days: 3, From: "2026-01-01", To: "2026-01-03"},
+		LongestNoSpend:  domain.Streak{Days: 2, From: "2026-01-04", To: "2026-01-05"},
+	}, streaks)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetStreaks_NoOutcomes(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC)
+	mockRepo.On("GetDailySums", ctx, &from, &to, "UTC", 123).Return([]domain.DailySum{}, nil)
+
+	streaks, err := service.GetStreaks(ctx, from, to, time.UTC, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Streak{}, streaks.LongestSpending)
+	assert.Equal(t, domain.Streak{Days: 28, From: "2026-02-01", To: "2026-02-28"}, streaks.LongestNoSpend)
+}
+
+func TestGetStreaks_InvalidDates(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
+
+	streaks, err := service.GetStreaks(ctx, from, to, time.UTC, 123)
+
+	assert.Nil(t, streaks)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+
+	mockRepo.AssertNotCalled(t, "GetDailySums")
+}
+
+func TestGetStreaks_RangeTooLong(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	streaks, err := service.GetStreaks(ctx, from, to, time.UTC, 123)
+
+	assert.Nil(t, streaks)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.Contains(t, err.Error(), "at most 3660 days")
+
+	mockRepo.AssertNotCalled(t, "GetDailySums")
 }
 
-func TestGetSum_RepoError(t *testing.T) {
+func TestGetSumByHour_InvalidDates(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
-	mockRepo.On("GetSumByCategory", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, 123).Return([]domain.CategorySum(nil), errors.New("repo error"))
+	to := time.Now()
+	from := to.Add(24 * time.Hour)
 
-	result, err := service.GetSum(ctx, nil, nil, 0, 123)
+	result, err := service.GetSumByHour(ctx, &from, &to, "UTC", 123)
 
-	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Equal(t, "repo error", err.Error())
-
-	mockRepo.AssertExpectations(t)
-	mockCategoryRepo.AssertNotCalled(t, "FindById")
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+	mockRepo.AssertNotCalled(t, "GetSumByHour")
 }
 
 func TestGetTotal_Success_NoFilters(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	expectedTotal := 4500
@@ -885,7 +1819,7 @@ func TestGetTotal_Success_NoFilters(t *testing.T) {
 func TestGetTotal_Success_WithFilters(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -906,7 +1840,7 @@ func TestGetTotal_Success_WithFilters(t *testing.T) {
 func TestGetTotal_InvalidDates(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	to := time.Now()
@@ -926,7 +1860,7 @@ func TestGetTotal_InvalidDates(t *testing.T) {
 func TestGetTotal_RepoError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	mockRepo.On("GetTotalSum", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 123).Return(0, errors.New("repo error"))
@@ -944,7 +1878,7 @@ func TestGetTotal_RepoError(t *testing.T) {
 func TestGetSeries_Success_NoFilters(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -984,7 +1918,7 @@ func TestGetSeries_Success_NoFilters(t *testing.T) {
 func TestGetSeries_Success_WithFilters(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -1014,7 +1948,7 @@ func TestGetSeries_Success_WithFilters(t *testing.T) {
 func TestGetSeries_InvalidDates(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	to := time.Now()
@@ -1033,7 +1967,7 @@ func TestGetSeries_InvalidDates(t *testing.T) {
 func TestGetSeries_RepoError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -1052,7 +1986,7 @@ func TestGetSeries_RepoError(t *testing.T) {
 func TestGetTotalSeries_Success_NoFilters(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -1084,7 +2018,7 @@ func TestGetTotalSeries_Success_NoFilters(t *testing.T) {
 func TestGetTotalSeries_Success_WithFilters(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -1112,7 +2046,7 @@ func TestGetTotalSeries_Success_WithFilters(t *testing.T) {
 func TestGetTotalSeries_InvalidDates(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	to := time.Now()
@@ -1131,7 +2065,7 @@ func TestGetTotalSeries_InvalidDates(t *testing.T) {
 func TestGetTotalSeries_RepoError(t *testing.T) {
 	mockRepo := new(mocks.OutcomeRepository)
 	mockCategoryRepo := new(mocks.CategoryRepository)
-	service := NewOutcomeService(mockRepo, mockCategoryRepo)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
 	ctx := context.Background()
 
 	userId := 123
@@ -1146,3 +2080,468 @@ func TestGetTotalSeries_RepoError(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 	mockCategoryRepo.AssertNotCalled(t, "FindById")
 }
+
+func TestGetNewCategories_CategoryOnlyInCurrentMonth(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+	userId := 123
+
+	month := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+	currentFrom := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	currentTo := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	previousFrom := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	previousTo := currentFrom.Add(-time.Nanosecond)
+
+	// Uncategorized outcomes (category 0) are not a new category
+	mockRepo.On("GetSumByCategory", ctx, &currentFrom, &currentTo, 0, userId).Return([]domain.CategorySum{
+		{CategoryId: 0, Total: 300},
+		{CategoryId: 1, Total: 5000},
+		{CategoryId: 2, Total: 1200},
+		{CategoryId: 3, Total: 0},
+	}, nil)
+	mockRepo.On("GetSumByCategory", ctx, &previousFrom, &previousTo, 0, userId).Return([]domain.CategorySum{
+		{CategoryId: 1, Total: 4000},
+		{CategoryId: 2, Total: 0},
+		{CategoryId: 3, Total: 700},
+	}, nil)
+
+	result, err := service.GetNewCategories(ctx, month, userId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CategorySum{{CategoryId: 2, Total: 1200}}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetNewCategories_NoCurrentSpending(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockRepo.On("GetSumByCategory", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, 123).Return(nil, nil)
+
+	result, err := service.GetNewCategories(ctx, time.Now(), 123)
+
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+	assert.NotNil(t, result)
+}
+
+func TestGetNewCategories_RepoError(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockRepo.On("GetSumByCategory", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 0, 123).Return(nil, errors.New("db error"))
+
+	result, err := service.GetNewCategories(ctx, time.Now(), 123)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestGetYearOverYear_Success(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+	userId := 123
+
+	month := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+	currentFrom := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	currentTo := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	previousFrom := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	previousTo := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+
+	mockRepo.On("GetTotalSum", ctx, &currentFrom, &currentTo, userId).Return(150000, nil)
+	mockRepo.On("GetTotalSum", ctx, &previousFrom, &previousTo, userId).Return(120000, nil)
+
+	result, err := service.GetYearOverYear(ctx, month, userId)
+
+	assert.NoError(t, err)
+	percent := 25.0
+	assert.Equal(t, &domain.YearOverYear{
+		Month:         "2026-03",
+		Total:         150000,
+		PreviousMonth: "2025-03",
+		PreviousTotal: 120000,
+		Delta:         30000,
+		Percent:       &percent,
+	}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetYearOverYear_NoPreviousData(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+	userId := 123
+
+	previousFrom := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("GetTotalSum", ctx, mock.MatchedBy(func(from *time.Time) bool { return !from.Equal(previousFrom) }), mock.AnythingOfType("*time.Time"), userId).Return(8000, nil)
+	mockRepo.On("GetTotalSum", ctx, &previousFrom, mock.AnythingOfType("*time.Time"), userId).Return(0, nil)
+
+	result, err := service.GetYearOverYear(ctx, time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), userId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 8000, result.Delta)
+	assert.Nil(t, result.Percent)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetYearOverYear_RepoError(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockRepo.On("GetTotalSum", ctx, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time"), 123).Return(0, errors.New("db error"))
+
+	result, err := service.GetYearOverYear(ctx, time.Now(), 123)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCreateOutcome_NameAtMaxLength(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.ValidationConfig{MaxNameLength: 10})
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	name := strings.Repeat("a", 10)
+	mockCategoryRepo.On("FindById", ctx, 1, 123).Return(&domain.Category{ID: 1, UserId: 123}, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, 123).Return(nil, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil)
+
+	outcome, err := service.Create(ctx, name, 1000, 1, &createdAt, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, name, outcome.Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateOutcome_NameOverMaxLength(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.ValidationConfig{MaxNameLength: 10})
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	outcome, err := service.Create(ctx, strings.Repeat("a", 11), 1000, 1, &createdAt, 123)
+
+	assert.Nil(t, outcome)
+	var invalidErr *domain.InvalidEntityError
+	assert.True(t, errors.As(err, &invalidErr))
+	assert.Equal(t, "name must be at most 10 characters", invalidErr.UnderlyingCause.Error())
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestPatchOutcomeById_NameOverMaxLength(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.ValidationConfig{MaxNameLength: 10})
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, Name: "Rent", Amount: 1000, CategoryId: 1, UserId: 123}, nil)
+
+	outcome, err := service.PatchById(ctx, 1, strings.Repeat("a", 11), 0, 0, nil, 123)
+
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCreateOutcome_NumericName_RejectedWithFlag(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.ValidationConfig{RejectNumericNames: true})
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	outcome, err := service.Create(ctx, " 123 ", 1000, 1, &createdAt, 123)
+
+	assert.Nil(t, outcome)
+	var invalidErr *domain.InvalidEntityError
+	assert.True(t, errors.As(err, &invalidErr))
+	assert.Equal(t, "name cannot be only digits", invalidErr.UnderlyingCause.Error())
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestCreateOutcome_NumericName_AllowedWithoutFlag(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	mockCategoryRepo.On("FindById", ctx, 1, 123).Return(&domain.Category{ID: 1, UserId: 123}, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, 123).Return(nil, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil)
+
+	outcome, err := service.Create(ctx, "123", 1000, 1, &createdAt, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "123", outcome.Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateOutcome_NameWithDigits_AllowedWithFlag(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.ValidationConfig{RejectNumericNames: true})
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	mockCategoryRepo.On("FindById", ctx, 1, 123).Return(&domain.Category{ID: 1, UserId: 123}, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, 123).Return(nil, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil)
+
+	outcome, err := service.Create(ctx, "Route 66", 1000, 1, &createdAt, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Route 66", outcome.Name)
+}
+
+func TestPatchOutcomeById_NumericName_RejectedWithFlag(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.ValidationConfig{RejectNumericNames: true})
+	ctx := context.Background()
+
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, Name: "Rent", Amount: 1000, CategoryId: 1, UserId: 123}, nil)
+
+	outcome, err := service.PatchById(ctx, 1, "42", 0, 0, nil, 123)
+
+	assert.Nil(t, outcome)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestGetProjection_MidMonth(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	now := time.Date(2025, 4, 15, 12, 0, 0, 0, time.UTC)
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("GetTotalSum", ctx, &from, &now, 123).Return(45000, nil)
+
+	projection, err := service.GetProjection(ctx, now, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.SpendingProjection{
+		SpentSoFar:     45000,
+		DaysElapsed:    15,
+		DaysInMonth:    30,
+		ProjectedTotal: 90000,
+	}, projection)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetProjection_FirstDayOfMonth(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	now := time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC)
+	mockRepo.On("GetTotalSum", ctx, mock.AnythingOfType("*time.Time"), &now, 123).Return(1000, nil)
+
+	projection, err := service.GetProjection(ctx, now, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, projection.DaysElapsed)
+	assert.Equal(t, 29, projection.DaysInMonth)
+	assert.Equal(t, 29000, projection.ProjectedTotal)
+}
+
+func TestGetProjection_RepoError(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	now := time.Date(2025, 4, 15, 12, 0, 0, 0, time.UTC)
+	mockRepo.On("GetTotalSum", ctx, mock.AnythingOfType("*time.Time"), &now, 123).Return(0, errors.New("repo error"))
+
+	projection, err := service.GetProjection(ctx, now, 123)
+
+	assert.Nil(t, projection)
+	assert.EqualError(t, err, "repo error")
+}
+
+func TestBulkPatch_Success(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	createdAt := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	newDate := time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("WithinTx", ctx, mock.Anything).Return(nil)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, UserId: 123, Name: "Lunch", Amount: 1200, CategoryId: 1, CreatedAt: &createdAt}, nil)
+	mockRepo.On("FindById", ctx, 2, 123).Return(&domain.Outcome{ID: 2, UserId: 123, Name: "Train", Amount: 4500, CategoryId: 1, CreatedAt: &createdAt}, nil)
+	mockCategoryRepo.On("FindById", ctx, 2, 123).Return(&domain.Category{ID: 2, UserId: 123}, nil)
+	mockCategoryRepo.On("FindAllowedIds", ctx, 123).Return(nil, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil)
+
+	outcomes, err := service.BulkPatch(ctx, []domain.OutcomePatch{
+		{ID: 1, CategoryId: 2},
+		{ID: 2, CreatedAt: &newDate},
+	}, 123)
+
+	assert.NoError(t, err)
+	assert.Len(t, outcomes, 2)
+	assert.Equal(t, 2, outcomes[0].CategoryId)
+	assert.Equal(t, "Lunch", outcomes[0].Name)
+	assert.Equal(t, &newDate, outcomes[1].CreatedAt)
+	assert.Equal(t, 4500, outcomes[1].Amount)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestBulkPatch_PartialFailureRollsBack(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	createdAt := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("WithinTx", ctx, mock.Anything).Return(nil)
+	mockRepo.On("FindById", ctx, 1, 123).Return(&domain.Outcome{ID: 1, UserId: 123, Name: "Lunch", Amount: 1200, CategoryId: 1, CreatedAt: &createdAt}, nil)
+	mockRepo.On("FindById", ctx, 99, 123).Return(nil, pgx.ErrNoRows)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.Outcome")).Return(nil)
+
+	outcomes, err := service.BulkPatch(ctx, []domain.OutcomePatch{
+		{ID: 1, Amount: 1500},
+		{ID: 99, Amount: 2000},
+		{ID: 1, Amount: -5},
+	}, 123)
+
+	// The transaction callback failed, so the repository rolls back the first update
+	assert.Nil(t, outcomes)
+	bulkErr, ok := errors.AsType[*domain.BulkError](err)
+	assert.True(t, ok)
+	assert.Len(t, bulkErr.Errors, 2)
+	assert.Equal(t, 1, bulkErr.Errors[0].Index)
+	assert.IsType(t, &domain.EntityNotFoundError{}, bulkErr.Errors[0].Err)
+	assert.Equal(t, 2, bulkErr.Errors[1].Index)
+	assert.IsType(t, &domain.InvalidEntityError{}, bulkErr.Errors[1].Err)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBulkPatch_RepoErrorAborts(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	mockRepo.On("WithinTx", ctx, mock.Anything).Return(nil)
+	mockRepo.On("FindById", ctx, 1, 123).Return(nil, errors.New("connection reset"))
+
+	outcomes, err := service.BulkPatch(ctx, []domain.OutcomePatch{
+		{ID: 1, Amount: 1500},
+		{ID: 2, Amount: 2000},
+	}, 123)
+
+	assert.Nil(t, outcomes)
+	assert.EqualError(t, err, "connection reset")
+	mockRepo.AssertNotCalled(t, "FindById", ctx, 2, 123)
+}
+
+func TestGetRollingTotal_Success(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	from := time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)
+	mockRepo.On("GetTotalSum", ctx, &from, &now, 123).Return(4200, nil)
+
+	rolling, err := service.GetRollingTotal(ctx, now, 30, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.RollingTotal{From: from, To: now, Total: 4200}, rolling)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetRollingTotal_WindowOutOfRange(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	for _, days := range []int{0, -1, domain.MaxRollingWindowDays + 1} {
+		rolling, err := service.GetRollingTotal(ctx, time.Now(), days, 123)
+
+		assert.Nil(t, rolling)
+		assert.IsType(t, &domain.InvalidEntityError{}, err)
+	}
+	mockRepo.AssertNotCalled(t, "GetTotalSum")
+}
+
+func TestFutureTo_ClampedConsistently(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	validation := config.DefaultValidationConfig()
+	validation.ClampFutureTo = true
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, validation)
+	ctx := context.Background()
+
+	from := time.Now().AddDate(0, -1, 0)
+	future := time.Now().AddDate(0, 0, 10)
+	clamped := mock.MatchedBy(func(to *time.Time) bool {
+		return to != nil && !to.After(time.Now())
+	})
+
+	mockRepo.On("FindAll", ctx, &from, clamped, 0, 123, 20, 0).Return([]domain.Outcome{}, nil)
+	mockRepo.On("CountAll", ctx, &from, clamped, 0, 123).Return(0, nil)
+	mockRepo.On("GetTotalSum", ctx, &from, clamped, 123).Return(0, nil)
+	mockRepo.On("GetSumByCategory", ctx, &from, clamped, 0, 123).Return([]domain.CategorySum{}, nil)
+
+	_, _, err := service.GetAll(ctx, &from, &future, 0, 123, 20, 0)
+	assert.NoError(t, err)
+	_, err = service.GetTotal(ctx, &from, &future, 123)
+	assert.NoError(t, err)
+	_, err = service.GetSum(ctx, &from, &future, 0, 123)
+	assert.NoError(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFutureTo_HonoredConsistentlyByDefault(t *testing.T) {
+	mockRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewOutcomeService(mockRepo, mockCategoryRepo, config.DefaultValidationConfig())
+	ctx := context.Background()
+
+	from := time.Now().AddDate(0, -1, 0)
+	future := time.Now().AddDate(0, 0, 10)
+
+	mockRepo.On("FindAll", ctx, &from, &future, 0, 123, 20, 0).Return([]domain.Outcome{}, nil)
+	mockRepo.On("CountAll", ctx, &from, &future, 0, 123).Return(0, nil)
+	mockRepo.On("GetTotalSum", ctx, &from, &future, 123).Return(0, nil)
+	mockRepo.On("GetSumByCategory", ctx, &from, &future, 0, 123).Return([]domain.CategorySum{}, nil)
+
+	_, _, err := service.GetAll(ctx, &from, &future, 0, 123, 20, 0)
+	assert.NoError(t, err)
+	_, err = service.GetTotal(ctx, &from, &future, 123)
+	assert.NoError(t, err)
+	_, err = service.GetSum(ctx, &from, &future, 0, 123)
+	assert.NoError(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/infrastructure/repository"
+)
+
+type ReportServiceInterface interface {
+	GetSpendVsIncome(ctx context.Context, from time.Time, to time.Time, userId int) (*domain.SpendVsIncome, error)
+	GetPayPeriodBalance(ctx context.Context, now time.Time, anchor time.Time, lengthDays int, userId int) (*domain.PayPeriodBalance, error)
+	GetSavingsRate(ctx context.Context, from time.Time, to time.Time, userId int) ([]domain.MonthlySavingsRate, error)
+	GetMonthlyStatement(ctx context.Context, from time.Time, to time.Time, userId int) (*domain.MonthlyStatement, error)
+}
+
+type ReportService struct {
+	incomeRepo   repository.IncomeRepository
+	outcomeRepo  repository.OutcomeRepository
+	categoryRepo repository.CategoryRepository
+}
+
+func NewReportService(incomeRepo repository.IncomeRepository, outcomeRepo repository.OutcomeRepository, categoryRepo repository.CategoryRepository) *ReportService {
+	return &ReportService{incomeRepo: incomeRepo, outcomeRepo: outcomeRepo, categoryRepo: categoryRepo}
+}
+
+func (s *ReportService) GetSpendVsIncome(ctx context.Context, from time.Time, to time.Time, userId int) (*domain.SpendVsIncome, error) {
+	if from.After(to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	income, err := s.incomeRepo.GetTotalSum(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	sums, err := s.outcomeRepo.GetSumByCategory(ctx, &from, &to, 0, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.SpendVsIncome{
+		Income:     income,
+		Categories: []domain.CategoryIncomeShare{},
+	}
+	for _, sum := range sums {
+		report.Spent += sum.Total
+		report.Categories = append(report.Categories, domain.CategoryIncomeShare{
+			CategoryId:      sum.CategoryId,
+			Total:           sum.Total,
+			PercentOfIncome: percentOf(sum.Total, income),
+		})
+	}
+	report.SavingsRate = percentOf(income-report.Spent, income)
+
+	return report, nil
+}
+
+// GetPayPeriodBalance returns the income minus the outcomes of the pay period
+// containing now. Pay periods are lengthDays long, one of them starting on the
+// anchor day, so the anchor may be any past or future pay day.
+func (s *ReportService) GetPayPeriodBalance(ctx context.Context, now time.Time, anchor time.Time, lengthDays int, userId int) (*domain.PayPeriodBalance, error) {
+	if lengthDays <= 0 || lengthDays > domain.MaxPayPeriodDays {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: fmt.Errorf("length must be between 1 and %d days", domain.MaxPayPeriodDays),
+		}
+	}
+
+	from, to := payPeriodBounds(now, anchor, lengthDays)
+
+	income, err := s.incomeRepo.GetTotalSum(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	spent, err := s.outcomeRepo.GetTotalSum(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PayPeriodBalance{
+		From:   from,
+		To:     to,
+		Income: income,
+		Spent:  spent,
+		Net:    income - spent,
+	}, nil
+}
+
+// GetSavingsRate returns the savings rate of each month between from and to,
+// computed from the monthly income and outcome totals.
+func (s *ReportService) GetSavingsRate(ctx context.Context, from time.Time, to time.Time, userId int) ([]domain.MonthlySavingsRate, error) {
+	if from.After(to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	incomes, err := s.incomeRepo.GetMonthlyTotalSeries(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes, err := s.outcomeRepo.GetMonthlyTotalSeries(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+	spent := make(map[string]int, len(outcomes))
+	for _, o := range outcomes {
+		spent[o.Month] = o.Total
+	}
+
+	rates := make([]domain.MonthlySavingsRate, 0, len(incomes))
+	for _, i := range incomes {
+		rates = append(rates, domain.MonthlySavingsRate{
+			Month:       i.Month,
+			Income:      i.Total,
+			Outcome:     spent[i.Month],
+			SavingsRate: percentOf(i.Total-spent[i.Month], i.Total),
+		})
+	}
+
+	return rates, nil
+}
+
+// GetMonthlyStatement returns the income and outcome totals between from and to,
+// along with the spend of each category. Uncategorized outcomes, summed under the
+// category id 0, make a line without label.
+func (s *ReportService) GetMonthlyStatement(ctx context.Context, from time.Time, to time.Time, userId int) (*domain.MonthlyStatement, error) {
+	if from.After(to) {
+		return nil, &domain.InvalidDateError{
+			UnderlyingCause: errors.New("start date must be before end date"),
+		}
+	}
+
+	income, err := s.incomeRepo.GetTotalSum(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome, err := s.outcomeRepo.GetTotalSum(ctx, &from, &to, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	sums, err := s.outcomeRepo.GetSumByCategory(ctx, &from, &to, 0, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepo.FindAll(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[int]string, len(categories))
+	for _, c := range categories {
+		labels[c.ID] = c.Label
+	}
+
+	statement := &domain.MonthlyStatement{
+		Income:     income,
+		Outcome:    outcome,
+		Net:        income - outcome,
+		Categories: []domain.CategoryStatementLine{},
+	}
+	for _, sum := range sums {
+		if sum.Total == 0 {
+			continue
+		}
+		statement.Categories = append(statement.Categories, domain.CategoryStatementLine{
+			Label: labels[sum.CategoryId],
+			Total: sum.Total,
+		})
+	}
+	slices.SortStableFunc(statement.Categories, func(a, b domain.CategoryStatementLine) int {
+		return b.Total - a.Total
+	})
+
+	return statement, nil
+}
+
+// payPeriodBounds returns the first and last instants of the pay period containing
+// now. Days are counted on the calendar of the anchor's location, so that periods
+// always start at midnight across DST changes.
+func payPeriodBounds(now time.Time, anchor time.Time, lengthDays int) (time.Time, time.Time) {
+	loc := anchor.Location()
+	anchor = time.Date(anchor.Year(), anchor.Month(), anchor.Day(), 0, 0, 0, 0, loc)
+	now = now.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	days := dayNumber(today) - dayNumber(anchor)
+	periods := days / lengthDays
+	if days%lengthDays < 0 {
+		// Round down rather than toward zero when now is before the anchor
+		periods--
+	}
+
+	from := anchor.AddDate(0, 0, periods*lengthDays)
+	to := from.AddDate(0, 0, lengthDays).Add(-time.Nanosecond)
+	return from, to
+}
+
+// dayNumber numbers the calendar day of t, consecutive days having consecutive
+// numbers. Unlike a time.Duration it does not overflow for distant dates.
+func dayNumber(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// percentOf returns part as a percentage of whole rounded to two decimals, or nil
+// when whole is zero.
+func percentOf(part int, whole int) *float64 {
+	if whole == 0 {
+		return nil
+	}
+	percent := math.Round(float64(part)*10000/float64(whole)) / 100
+	return &percent
+}
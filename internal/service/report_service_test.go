@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/infrastructure/repository/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportService_GetSpendVsIncome_Success(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewReportService(mockIncomeRepo, mockOutcomeRepo, new(mocks.CategoryRepository))
+	ctx := context.Background()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	mockIncomeRepo.On("GetTotalSum", ctx, &from, &to, 123).Return(300000, nil)
+	mockOutcomeRepo.On("GetSumByCategory", ctx, &from, &to, 0, 123).Return([]domain.CategorySum{
+		{CategoryId: 1, Total: 90000},
+		{CategoryId: 2, Total: 15000},
+		{CategoryId: 3, Total: 1000},
+	}, nil)
+
+	report, err := service.GetSpendVsIncome(ctx, from, to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 300000, report.Income)
+	assert.Equal(t, 106000, report.Spent)
+	assert.Equal(t, 64.67, *report.SavingsRate)
+	assert.Len(t, report.Categories, 3)
+	assert.Equal(t, 30.0, *report.Categories[0].PercentOfIncome)
+	assert.Equal(t, 5.0, *report.Categories[1].PercentOfIncome)
+	assert.Equal(t, 0.33, *report.Categories[2].PercentOfIncome)
+
+	mockIncomeRepo.AssertExpectations(t)
+	mockOutcomeRepo.AssertExpectations(t)
+}
+
+func TestReportService_GetSpendVsIncome_Uncategorized(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewReportService(mockIncomeRepo, mockOutcomeRepo, new(mocks.CategoryRepository))
+	ctx := context.Background()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	mockIncomeRepo.On("GetTotalSum", ctx, &from, &to, 123).Return(100000, nil)
+	mockOutcomeRepo.On("GetSumByCategory", ctx, &from, &to, 0, 123).Return([]domain.CategorySum{
+		{CategoryId: 0, Total: 20000},
+		{CategoryId: 1, Total: 30000},
+	}, nil)
+
+	report, err := service.GetSpendVsIncome(ctx, from, to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 50000, report.Spent)
+	assert.Equal(t, 50.0, *report.SavingsRate)
+	assert.Equal(t, 0, report.Categories[0].CategoryId)
+	assert.Equal(t, 20.0, *report.Categories[0].PercentOfIncome)
+}
+
+func TestReportService_GetSpendVsIncome_NoIncome(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewReportService(mockIncomeRepo, mockOutcomeRepo, new(mocks.CategoryRepository))
+	ctx := context.Background()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	mockIncomeRepo.On("GetTotalSum", ctx, &from, &to, 123).Return(0, nil)
+	mockOutcomeRepo.On("GetSumByCategory", ctx, &from, &to, 0, 123).Return([]domain.CategorySum{
+		{CategoryId: 1, Total: 5000},
+	}, nil)
+
+	report, err := service.GetSpendVsIncome(ctx, from, to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5000, report.Spent)
+	assert.Nil(t, report.SavingsRate)
+	assert.Nil(t, report.Categories[0].PercentOfIncome)
+}
+
+func TestReportService_GetSpendVsIncome_RepoError(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewReportService(mockIncomeRepo, mockOutcomeRepo, new(mocks.CategoryRepository))
+	ctx := context.Background()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	mockIncomeRepo.On("GetTotalSum", ctx, &from, &to, 123).Return(0, errors.New("db error"))
+
+	report, err := service.GetSpendVsIncome(ctx, from, to, 123)
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+	mockOutcomeRepo.AssertNotCalled(t, "GetSumByCategory")
+}
+
+func TestReportService_GetPayPeriodBalance_Window(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		now          time.Time
+		anchor       time.Time
+		lengthDays   int
+		expectedFrom time.Time
+	}{
+		{
+			name:         "anchor in the past",
+			now:          time.Date(2026, 3, 20, 15, 0, 0, 0, time.UTC),
+			anchor:       time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			lengthDays:   14,
+			expectedFrom: time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "now on a pay day",
+			now:          time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC),
+			anchor:       time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			lengthDays:   14,
+			expectedFrom: time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "anchor in the future",
+			now:          time.Date(2026, 3, 20, 15, 0, 0, 0, time.UTC),
+			anchor:       time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC),
+			lengthDays:   14,
+			expectedFrom: time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "period spanning a DST change",
+			now:          time.Date(2026, 4, 2, 9, 0, 0, 0, paris),
+			anchor:       time.Date(2026, 3, 20, 0, 0, 0, 0, paris),
+			lengthDays:   7,
+			expectedFrom: time.Date(2026, 3, 27, 0, 0, 0, 0, paris),
+		},
+		{
+			name:         "anchor centuries ago",
+			now:          time.Date(2026, 3, 20, 15, 0, 0, 0, time.UTC),
+			anchor:       time.Date(1500, 1, 1, 0, 0, 0, 0, time.UTC),
+			lengthDays:   7,
+			expectedFrom: time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIncomeRepo := new(mocks.IncomeRepository)
+			mockOutcomeRepo := new(mocks.OutcomeRepository)
+			service := NewReportService(mockIncomeRepo, mockOutcomeRepo, new(mocks.CategoryRepository))
+			ctx := context.Background()
+
+			expectedTo := tt.expectedFrom.AddDate(0, 0, tt.lengthDays).Add(-time.Nanosecond)
+			mockIncomeRepo.On("GetTotalSum", ctx, &tt.expectedFrom, &expectedTo, 123).Return(250000, nil)
+			mockOutcomeRepo.On("GetTotalSum", ctx, &tt.expectedFrom, &expectedTo, 123).Return(90000, nil)
+
+			balance, err := service.GetPayPeriodBalance(ctx, tt.now, tt.anchor, tt.lengthDays, 123)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &domain.PayPeriodBalance{
+				From:   tt.expectedFrom,
+				To:     expectedTo,
+				Income: 250000,
+				Spent:  90000,
+				Net:    160000,
+			}, balance)
+			mockIncomeRepo.AssertExpectations(t)
+			mockOutcomeRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReportService_GetPayPeriodBalance_InvalidLength(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewReportService(mockIncomeRepo, mockOutcomeRepo, new(mocks.CategoryRepository))
+
+	for _, lengthDays := range []int{0, -14, domain.MaxPayPeriodDays + 1} {
+		balance, err := service.GetPayPeriodBalance(context.Background(), time.Now(), time.Now(), lengthDays, 123)
+
+		assert.Nil(t, balance)
+		assert.IsType(t, &domain.InvalidEntityError{}, err)
+	}
+	mockIncomeRepo.AssertNotCalled(t, "GetTotalSum")
+}
+
+func TestReportService_GetSavingsRate(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewReportService(mockIncomeRepo, mockOutcomeRepo, new(mocks.CategoryRepository))
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	mockIncomeRepo.On("GetMonthlyTotalSeries", ctx, &from, &to, 123).Return([]domain.MonthlyTotalSeries{
+		{Month: "2026-01", Total: 300000},
+		{Month: "2026-02", Total: 0},
+		{Month: "2026-03", Total: 200000},
+	}, nil)
+	mockOutcomeRepo.On("GetMonthlyTotalSeries", ctx, &from, &to, 123).Return([]domain.MonthlyTotalSeries{
+		{Month: "2026-01", Total: 106000},
+		{Month: "2026-02", Total: 5000},
+		{Month: "2026-03", Total: 250000},
+	}, nil)
+
+	rates, err := service.GetSavingsRate(ctx, from, to, 123)
+
+	savingsRate := 64.67
+	assert.NoError(t, err)
+	assert.Len(t, rates, 3)
+	assert.Equal(t, domain.MonthlySavingsRate{Month: "2026-01", Income: 300000, Outcome: 106000, SavingsRate: &savingsRate}, rates[0])
+	assert.Equal(t, domain.MonthlySavingsRate{Month: "2026-02", Income: 0, Outcome: 5000, SavingsRate: nil}, rates[1])
+	assert.Equal(t, -25.0, *rates[2].SavingsRate)
+
+	mockIncomeRepo.AssertExpectations(t)
+	mockOutcomeRepo.AssertExpectations(t)
+}
+
+func TestReportService_GetSavingsRate_InvalidDates(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	service := NewReportService(mockIncomeRepo, mockOutcomeRepo, new(mocks.CategoryRepository))
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rates, err := service.GetSavingsRate(context.Background(), from, to, 123)
+
+	assert.Nil(t, rates)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+	mockIncomeRepo.AssertNotCalled(t, "GetMonthlyTotalSeries")
+}
+
+func TestReportService_GetMonthlyStatement(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	mockOutcomeRepo := new(mocks.OutcomeRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	service := NewReportService(mockIncomeRepo, mockOutcomeRepo, mockCategoryRepo)
+	ctx := context.Background()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	mockIncomeRepo.On("GetTotalSum", ctx, &from, &to, 123).Return(300000, nil)
+	mockOutcomeRepo.On("GetTotalSum", ctx, &from, &to, 123).Return(110000, nil)
+	mockOutcomeRepo.On("GetSumByCategory", ctx, &from, &to, 0, 123).Return([]domain.CategorySum{
+		{CategoryId: 0, Total: 5000},
+		{CategoryId: 1, Total: 15000},
+		{CategoryId: 2, Total: 90000},
+		{CategoryId: 3, Total: 0},
+	}, nil)
+	mockCategoryRepo.On("FindAll", ctx, 123).Return([]domain.Category{
+		{ID: 1, Label: "Food"},
+		{ID: 2, Label: "Rent"},
+		{ID: 3, Label: "Travel"},
+	}, nil)
+
+	statement, err := service.GetMonthlyStatement(ctx, from, to, 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.MonthlyStatement{
+		Income:  300000,
+		Outcome: 110000,
+		Net:     190000,
+		Categories: []domain.CategoryStatementLine{
+			{Label: "Rent", Total: 90000},
+			{Label: "Food", Total: 15000},
+			{Label: "", Total: 5000},
+		},
+	}, statement)
+	mockIncomeRepo.AssertExpectations(t)
+	mockOutcomeRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestReportService_GetMonthlyStatement_InvalidDates(t *testing.T) {
+	mockIncomeRepo := new(mocks.IncomeRepository)
+	service := NewReportService(mockIncomeRepo, new(mocks.OutcomeRepository), new(mocks.CategoryRepository))
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	statement, err := service.GetMonthlyStatement(context.Background(), from, to, 123)
+
+	assert.Nil(t, statement)
+	assert.IsType(t, &domain.InvalidDateError{}, err)
+	mockIncomeRepo.AssertNotCalled(t, "GetTotalSum")
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/kerhael/accounting/internal/infrastructure/repository"
+)
+
+type TimeService struct {
+	repo     repository.TimeRepository
+	location *time.Location
+}
+
+func NewTimeService(repo repository.TimeRepository, location *time.Location) *TimeService {
+	return &TimeService{repo: repo, location: location}
+}
+
+// Now returns the database clock expressed in the configured timezone.
+func (s *TimeService) Now(ctx context.Context) (time.Time, error) {
+	now, err := s.repo.Now(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return now.In(s.location), nil
+}
+
+func (s *TimeService) Location() *time.Location {
+	return s.location
+}
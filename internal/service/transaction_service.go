@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/infrastructure/repository"
+)
+
+type TransactionServiceInterface interface {
+	Search(ctx context.Context, term string, userId int, limit int, offset int) ([]domain.Transaction, int, error)
+}
+
+type TransactionService struct {
+	repo repository.TransactionRepository
+}
+
+func NewTransactionService(repo repository.TransactionRepository) *TransactionService {
+	return &TransactionService{repo: repo}
+}
+
+// Search returns the incomes and outcomes whose name contains term, case-insensitively,
+// most recent first, along with the total number of matches.
+func (s *TransactionService) Search(ctx context.Context, term string, userId int, limit int, offset int) ([]domain.Transaction, int, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, 0, &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("search term cannot be empty"),
+		}
+	}
+
+	transactions, err := s.repo.SearchByName(ctx, term, userId, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountByName(ctx, term, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return transactions, total, nil
+}
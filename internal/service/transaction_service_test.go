@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kerhael/accounting/internal/domain"
+	"github.com/kerhael/accounting/internal/infrastructure/repository/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionService_Search_Success(t *testing.T) {
+	mockRepo := new(mocks.TransactionRepository)
+	service := NewTransactionService(mockRepo)
+	ctx := context.Background()
+
+	expected := []domain.Transaction{
+		{Type: domain.TransactionTypeOutcome, ID: 7, Name: "Amazon order", Amount: 2599, CategoryId: 2},
+		{Type: domain.TransactionTypeIncome, ID: 3, Name: "Amazon refund", Amount: 1500},
+	}
+	mockRepo.On("SearchByName", ctx, "amazon", 123, 20, 0).Return(expected, nil)
+	mockRepo.On("CountByName", ctx, "amazon", 123).Return(2, nil)
+
+	transactions, total, err := service.Search(ctx, " amazon ", 123, 20, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, transactions)
+	assert.Equal(t, 2, total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionService_Search_EmptyTerm(t *testing.T) {
+	mockRepo := new(mocks.TransactionRepository)
+	service := NewTransactionService(mockRepo)
+
+	transactions, total, err := service.Search(context.Background(), "   ", 123, 20, 0)
+
+	assert.Nil(t, transactions)
+	assert.Equal(t, 0, total)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	mockRepo.AssertNotCalled(t, "SearchByName")
+}
+
+func TestTransactionService_Search_RepoError(t *testing.T) {
+	mockRepo := new(mocks.TransactionRepository)
+	service := NewTransactionService(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.On("SearchByName", ctx, "amazon", 123, 20, 0).Return(nil, errors.New("db error"))
+
+	transactions, _, err := service.Search(ctx, "amazon", 123, 20, 0)
+
+	assert.Nil(t, transactions)
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "CountByName")
+}
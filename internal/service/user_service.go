@@ -15,16 +15,17 @@ type UserServiceInterface interface {
 	Create(ctx context.Context, firstName string, lastName string, email string, password string) (*domain.User, error)
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
 	FindById(ctx context.Context, id int) (*domain.User, error)
-	PatchById(ctx context.Context, id int, firstName string, lastName string, password string) (*domain.User, error)
+	PatchById(ctx context.Context, id int, firstName string, lastName string, password string, defaultCategoryId *int, requireCategory *bool) (*domain.User, error)
 	DeleteById(ctx context.Context, id int) error
 }
 
 type UserService struct {
-	repo repository.UserRepository
+	repo         repository.UserRepository
+	categoryRepo repository.CategoryRepository
 }
 
-func NewUserService(repo repository.UserRepository) *UserService {
-	return &UserService{repo: repo}
+func NewUserService(repo repository.UserRepository, categoryRepo repository.CategoryRepository) *UserService {
+	return &UserService{repo: repo, categoryRepo: categoryRepo}
 }
 
 func (s *UserService) Create(ctx context.Context, firstName string, lastName string, email string, password string) (*domain.User, error) {
@@ -47,6 +48,14 @@ func (s *UserService) Create(ctx context.Context, firstName string, lastName str
 			UnderlyingCause: err,
 		}
 	}
+	if strings.TrimSpace(password) == "" {
+		return nil, &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("password is required"),
+		}
+	}
+	if err := checkPasswordLength(password); err != nil {
+		return nil, err
+	}
 	passwordHash, err := security.HashPassword(password)
 	if err != nil {
 		return nil, err
@@ -108,7 +117,9 @@ func (s *UserService) FindById(ctx context.Context, id int) (*domain.User, error
 	return user, nil
 }
 
-func (s *UserService) PatchById(ctx context.Context, id int, firstName string, lastName string, password string) (*domain.User, error) {
+// PatchById updates the non-empty fields. A nil defaultCategoryId leaves the
+// default category untouched, 0 clears it and any other value must be one of the user's categories.
+func (s *UserService) PatchById(ctx context.Context, id int, firstName string, lastName string, password string, defaultCategoryId *int, requireCategory *bool) (*domain.User, error) {
 	if id <= 0 {
 		return nil, &domain.InvalidEntityError{
 			UnderlyingCause: errors.New("invalid id"),
@@ -143,6 +154,9 @@ func (s *UserService) PatchById(ctx context.Context, id int, firstName string, l
 	}
 
 	if password != "" {
+		if err := checkPasswordLength(password); err != nil {
+			return nil, err
+		}
 		passwordHash, err := security.HashPassword(password)
 		if err != nil {
 			return nil, err
@@ -152,6 +166,28 @@ func (s *UserService) PatchById(ctx context.Context, id int, firstName string, l
 		u.PasswordHash = user.PasswordHash
 	}
 
+	u.DefaultCategoryId = user.DefaultCategoryId
+	if defaultCategoryId != nil {
+		if *defaultCategoryId < 0 {
+			return nil, &domain.InvalidEntityError{
+				UnderlyingCause: errors.New("invalid default category"),
+			}
+		}
+		if *defaultCategoryId > 0 {
+			if _, err := s.categoryRepo.FindById(ctx, *defaultCategoryId, id); err != nil {
+				return nil, &domain.InvalidEntityError{
+					UnderlyingCause: errors.New("invalid default category"),
+				}
+			}
+		}
+		u.DefaultCategoryId = *defaultCategoryId
+	}
+
+	u.RequireCategory = user.RequireCategory
+	if requireCategory != nil {
+		u.RequireCategory = *requireCategory
+	}
+
 	errUpdt := s.repo.Update(ctx, u)
 	if errUpdt != nil {
 		return nil, errUpdt
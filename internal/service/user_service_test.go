@@ -13,7 +13,7 @@ import (
 
 func TestUserService_Create_Success(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -40,7 +40,7 @@ func TestUserService_Create_Success(t *testing.T) {
 
 func TestUserService_Create_NormalizesEmail(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -56,7 +56,7 @@ func TestUserService_Create_NormalizesEmail(t *testing.T) {
 
 func TestUserService_Create_EmptyFirstName(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -74,7 +74,7 @@ func TestUserService_Create_EmptyFirstName(t *testing.T) {
 
 func TestUserService_Create_WhitespaceFirstName(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -91,7 +91,7 @@ func TestUserService_Create_WhitespaceFirstName(t *testing.T) {
 
 func TestUserService_Create_EmptyLastName(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -109,7 +109,7 @@ func TestUserService_Create_EmptyLastName(t *testing.T) {
 
 func TestUserService_Create_WhitespaceLastName(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -126,7 +126,7 @@ func TestUserService_Create_WhitespaceLastName(t *testing.T) {
 
 func TestUserService_Create_EmptyEmail(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -140,7 +140,7 @@ func TestUserService_Create_EmptyEmail(t *testing.T) {
 
 func TestUserService_Create_InvalidEmailFormat(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -152,9 +152,39 @@ func TestUserService_Create_InvalidEmailFormat(t *testing.T) {
 	mockRepo.AssertNotCalled(t, "Create")
 }
 
+func TestUserService_Create_EmptyPassword(t *testing.T) {
+	mockRepo := new(mocks.UserRepository)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
+
+	ctx := context.Background()
+
+	user, err := svc.Create(ctx, "John", "Doe", "john@example.com", "   ")
+
+	assert.Nil(t, user)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.EqualError(t, err, "invalid entity data: password is required")
+
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestUserService_Create_ShortPassword(t *testing.T) {
+	mockRepo := new(mocks.UserRepository)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
+
+	ctx := context.Background()
+
+	user, err := svc.Create(ctx, "John", "Doe", "john@example.com", "short")
+
+	assert.Nil(t, user)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.EqualError(t, err, "invalid entity data: password must be at least 8 characters")
+
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
 func TestUserService_Create_RepoError(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	repoErr := errors.New("db failure")
@@ -172,7 +202,7 @@ func TestUserService_Create_RepoError(t *testing.T) {
 
 func TestUserService_FindByEmail_Success(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	expectedUser := &domain.User{
@@ -198,7 +228,7 @@ func TestUserService_FindByEmail_Success(t *testing.T) {
 
 func TestUserService_FindByEmail_NormalizesEmail(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	expectedUser := &domain.User{
@@ -221,7 +251,7 @@ func TestUserService_FindByEmail_NormalizesEmail(t *testing.T) {
 
 func TestUserService_FindByEmail_EmptyEmail(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -238,7 +268,7 @@ func TestUserService_FindByEmail_EmptyEmail(t *testing.T) {
 
 func TestUserService_FindByEmail_WhitespaceEmail(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -255,7 +285,7 @@ func TestUserService_FindByEmail_WhitespaceEmail(t *testing.T) {
 
 func TestUserService_FindByEmail_InvalidEmailFormat(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -269,7 +299,7 @@ func TestUserService_FindByEmail_InvalidEmailFormat(t *testing.T) {
 
 func TestUserService_FindByEmail_UserNotFound(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	repoErr := errors.New("user not found")
@@ -287,7 +317,7 @@ func TestUserService_FindByEmail_UserNotFound(t *testing.T) {
 
 func TestUserService_FindById_Success(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	expectedUser := &domain.User{
@@ -313,7 +343,7 @@ func TestUserService_FindById_Success(t *testing.T) {
 
 func TestUserService_FindById_InvalidID(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -331,7 +361,7 @@ func TestUserService_FindById_InvalidID(t *testing.T) {
 
 func TestUserService_FindById_NegativeID(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -348,7 +378,7 @@ func TestUserService_FindById_NegativeID(t *testing.T) {
 
 func TestUserService_FindById_UserNotFound(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	repoErr := errors.New("user not found")
@@ -366,7 +396,7 @@ func TestUserService_FindById_UserNotFound(t *testing.T) {
 
 func TestUserService_PatchById_Success_AllFields(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	existingUser := &domain.User{
@@ -380,7 +410,7 @@ func TestUserService_PatchById_Success_AllFields(t *testing.T) {
 	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
 	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.User")).Return(nil)
 
-	user, err := svc.PatchById(ctx, 1, "Jane", "Smith", "newpassword")
+	user, err := svc.PatchById(ctx, 1, "Jane", "Smith", "newpassword", nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, user)
@@ -396,7 +426,7 @@ func TestUserService_PatchById_Success_AllFields(t *testing.T) {
 
 func TestUserService_PatchById_Success_FirstNameOnly(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	existingUser := &domain.User{
@@ -410,7 +440,7 @@ func TestUserService_PatchById_Success_FirstNameOnly(t *testing.T) {
 	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
 	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.User")).Return(nil)
 
-	user, err := svc.PatchById(ctx, 1, "Jane", "", "")
+	user, err := svc.PatchById(ctx, 1, "Jane", "", "", nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, user)
@@ -425,7 +455,7 @@ func TestUserService_PatchById_Success_FirstNameOnly(t *testing.T) {
 
 func TestUserService_PatchById_Success_LastNameOnly(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	existingUser := &domain.User{
@@ -439,7 +469,7 @@ func TestUserService_PatchById_Success_LastNameOnly(t *testing.T) {
 	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
 	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.User")).Return(nil)
 
-	user, err := svc.PatchById(ctx, 1, "", "Smith", "")
+	user, err := svc.PatchById(ctx, 1, "", "Smith", "", nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, user)
@@ -454,7 +484,7 @@ func TestUserService_PatchById_Success_LastNameOnly(t *testing.T) {
 
 func TestUserService_PatchById_Success_PasswordOnly(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	existingUser := &domain.User{
@@ -468,7 +498,7 @@ func TestUserService_PatchById_Success_PasswordOnly(t *testing.T) {
 	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
 	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.User")).Return(nil)
 
-	user, err := svc.PatchById(ctx, 1, "", "", "newpassword")
+	user, err := svc.PatchById(ctx, 1, "", "", "newpassword", nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, user)
@@ -482,9 +512,34 @@ func TestUserService_PatchById_Success_PasswordOnly(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestUserService_PatchById_ShortPassword(t *testing.T) {
+	mockRepo := new(mocks.UserRepository)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
+
+	ctx := context.Background()
+	existingUser := &domain.User{
+		ID:           1,
+		FirstName:    "John",
+		LastName:     "Doe",
+		Email:        "john@example.com",
+		PasswordHash: "oldhash",
+	}
+
+	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
+
+	user, err := svc.PatchById(ctx, 1, "", "", "short", nil, nil)
+
+	assert.Nil(t, user)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+	assert.EqualError(t, err, "invalid entity data: password must be at least 8 characters")
+
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
 func TestUserService_PatchById_Success_NoChanges(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	existingUser := &domain.User{
@@ -498,7 +553,7 @@ func TestUserService_PatchById_Success_NoChanges(t *testing.T) {
 	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
 	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.User")).Return(nil)
 
-	user, err := svc.PatchById(ctx, 1, "", "", "")
+	user, err := svc.PatchById(ctx, 1, "", "", "", nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, user)
@@ -513,11 +568,11 @@ func TestUserService_PatchById_Success_NoChanges(t *testing.T) {
 
 func TestUserService_PatchById_InvalidID_Zero(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
-	user, err := svc.PatchById(ctx, 0, "Jane", "Smith", "newpassword")
+	user, err := svc.PatchById(ctx, 0, "Jane", "Smith", "newpassword", nil, nil)
 
 	assert.Nil(t, user)
 	assert.Error(t, err)
@@ -532,11 +587,11 @@ func TestUserService_PatchById_InvalidID_Zero(t *testing.T) {
 
 func TestUserService_PatchById_InvalidID_Negative(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
-	user, err := svc.PatchById(ctx, -1, "Jane", "Smith", "newpassword")
+	user, err := svc.PatchById(ctx, -1, "Jane", "Smith", "newpassword", nil, nil)
 
 	assert.Nil(t, user)
 	assert.Error(t, err)
@@ -550,14 +605,14 @@ func TestUserService_PatchById_InvalidID_Negative(t *testing.T) {
 
 func TestUserService_PatchById_UserNotFound(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	repoErr := errors.New("user not found")
 
 	mockRepo.On("FindById", ctx, 999).Return((*domain.User)(nil), repoErr)
 
-	user, err := svc.PatchById(ctx, 999, "Jane", "Smith", "newpassword")
+	user, err := svc.PatchById(ctx, 999, "Jane", "Smith", "newpassword", nil, nil)
 
 	assert.Nil(t, user)
 	assert.Error(t, err)
@@ -569,7 +624,7 @@ func TestUserService_PatchById_UserNotFound(t *testing.T) {
 
 func TestUserService_PatchById_UpdateError(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	existingUser := &domain.User{
@@ -584,7 +639,7 @@ func TestUserService_PatchById_UpdateError(t *testing.T) {
 	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
 	mockRepo.On("Update", ctx, mock.AnythingOfType("*domain.User")).Return(repoErr)
 
-	user, err := svc.PatchById(ctx, 1, "Jane", "Smith", "newpassword")
+	user, err := svc.PatchById(ctx, 1, "Jane", "Smith", "newpassword", nil, nil)
 
 	assert.Nil(t, user)
 	assert.Error(t, err)
@@ -593,9 +648,76 @@ func TestUserService_PatchById_UpdateError(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestUserService_PatchById_SetDefaultCategory(t *testing.T) {
+	mockRepo := new(mocks.UserRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	svc := NewUserService(mockRepo, mockCategoryRepo)
+
+	ctx := context.Background()
+	existingUser := &domain.User{ID: 1, FirstName: "John", LastName: "Doe", Email: "john@example.com", PasswordHash: "hash"}
+	categoryId := 7
+
+	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
+	mockCategoryRepo.On("FindById", ctx, categoryId, 1).Return(&domain.Category{ID: categoryId, UserId: 1}, nil)
+	mockRepo.On("Update", ctx, mock.MatchedBy(func(u *domain.User) bool {
+		return u.DefaultCategoryId == categoryId && u.FirstName == "John"
+	})).Return(nil)
+
+	user, err := svc.PatchById(ctx, 1, "", "", "", &categoryId, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, categoryId, user.DefaultCategoryId)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestUserService_PatchById_DefaultCategoryNotOwned(t *testing.T) {
+	mockRepo := new(mocks.UserRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	svc := NewUserService(mockRepo, mockCategoryRepo)
+
+	ctx := context.Background()
+	existingUser := &domain.User{ID: 1, FirstName: "John", LastName: "Doe", Email: "john@example.com", PasswordHash: "hash"}
+	categoryId := 99
+
+	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
+	mockCategoryRepo.On("FindById", ctx, categoryId, 1).Return((*domain.Category)(nil), errors.New("no rows"))
+
+	user, err := svc.PatchById(ctx, 1, "", "", "", &categoryId, nil)
+
+	assert.Nil(t, user)
+	assert.IsType(t, &domain.InvalidEntityError{}, err)
+
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUserService_PatchById_ClearDefaultCategory(t *testing.T) {
+	mockRepo := new(mocks.UserRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	svc := NewUserService(mockRepo, mockCategoryRepo)
+
+	ctx := context.Background()
+	existingUser := &domain.User{ID: 1, FirstName: "John", LastName: "Doe", Email: "john@example.com", PasswordHash: "hash", DefaultCategoryId: 7}
+	unset := 0
+
+	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
+	mockRepo.On("Update", ctx, mock.MatchedBy(func(u *domain.User) bool {
+		return u.DefaultCategoryId == 0
+	})).Return(nil)
+
+	user, err := svc.PatchById(ctx, 1, "", "", "", &unset, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, user.DefaultCategoryId)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertNotCalled(t, "FindById", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestUserService_DeleteById_Success(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	userID := 123
@@ -611,7 +733,7 @@ func TestUserService_DeleteById_Success(t *testing.T) {
 
 func TestUserService_DeleteById_InvalidID_Zero(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -628,7 +750,7 @@ func TestUserService_DeleteById_InvalidID_Zero(t *testing.T) {
 
 func TestUserService_DeleteById_InvalidID_Negative(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 
@@ -644,7 +766,7 @@ func TestUserService_DeleteById_InvalidID_Negative(t *testing.T) {
 
 func TestUserService_DeleteById_RepoError(t *testing.T) {
 	mockRepo := new(mocks.UserRepository)
-	svc := NewUserService(mockRepo)
+	svc := NewUserService(mockRepo, new(mocks.CategoryRepository))
 
 	ctx := context.Background()
 	userID := 123
@@ -659,3 +781,25 @@ func TestUserService_DeleteById_RepoError(t *testing.T) {
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestUserService_PatchById_RequireCategory(t *testing.T) {
+	mockRepo := new(mocks.UserRepository)
+	mockCategoryRepo := new(mocks.CategoryRepository)
+	svc := NewUserService(mockRepo, mockCategoryRepo)
+
+	ctx := context.Background()
+	existingUser := &domain.User{ID: 1, FirstName: "John", LastName: "Doe", Email: "john@example.com", PasswordHash: "hash", DefaultCategoryId: 7, RequireCategory: true}
+	requireCategory := false
+
+	mockRepo.On("FindById", ctx, 1).Return(existingUser, nil)
+	mockRepo.On("Update", ctx, mock.MatchedBy(func(u *domain.User) bool {
+		return !u.RequireCategory && u.DefaultCategoryId == 7
+	})).Return(nil)
+
+	user, err := svc.PatchById(ctx, 1, "", "", "", nil, &requireCategory)
+
+	assert.NoError(t, err)
+	assert.False(t, user.RequireCategory)
+
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kerhael/accounting/internal/domain"
+)
+
+// uniqueViolation is the SQLSTATE of unique constraint violations.
+const uniqueViolation = "23505"
+
+// minPasswordLength is the minimum length of user passwords, in bytes.
+const minPasswordLength = 8
+
+var colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// checkMaxLength rejects values longer than maxLength characters. A zero
+// maxLength disables the check.
+func checkMaxLength(field string, value string, maxLength int) error {
+	if maxLength > 0 && utf8.RuneCountInString(value) > maxLength {
+		return &domain.InvalidEntityError{
+			UnderlyingCause: fmt.Errorf("%s must be at most %d characters", field, maxLength),
+		}
+	}
+	return nil
+}
+
+// checkNotNumeric rejects values made only of digits once trimmed, such as "123",
+// when reject is enabled.
+func checkNotNumeric(field string, value string, reject bool) error {
+	value = strings.TrimSpace(value)
+	if !reject || value == "" {
+		return nil
+	}
+	for _, r := range value {
+		if !unicode.IsDigit(r) {
+			return nil
+		}
+	}
+	return &domain.InvalidEntityError{
+		UnderlyingCause: fmt.Errorf("%s cannot be only digits", field),
+	}
+}
+
+// checkPasswordLength rejects passwords shorter than minPasswordLength.
+func checkPasswordLength(password string) error {
+	if len(password) < minPasswordLength {
+		return &domain.InvalidEntityError{
+			UnderlyingCause: fmt.Errorf("password must be at least %d characters", minPasswordLength),
+		}
+	}
+	return nil
+}
+
+// normalizeLabel collapses whitespace and title-cases each word, so that
+// "  food   court " becomes "Food Court".
+func normalizeLabel(label string) string {
+	words := strings.Fields(label)
+	for i, word := range words {
+		runes := []rune(strings.ToLower(word))
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
+
+// checkDuplicateLabel rejects a label already used by another category. Internal
+// whitespace is collapsed before comparing, so "Food  Court" and "Food Court" clash.
+func checkDuplicateLabel(label string, categories []domain.Category, excludeId int) error {
+	collapsed := strings.Join(strings.Fields(label), " ")
+	for _, c := range categories {
+		if c.ID != excludeId && strings.Join(strings.Fields(c.Label), " ") == collapsed {
+			return &domain.InvalidEntityError{
+				UnderlyingCause: errors.New("category already exists"),
+			}
+		}
+	}
+	return nil
+}
+
+// checkLabelConflict turns the unique violation raised when another request stored
+// the same label first, past checkDuplicateLabel, into the same InvalidEntityError.
+// Other errors are returned as is.
+func checkLabelConflict(err error) error {
+	if pgErr, ok := errors.AsType[*pgconn.PgError](err); ok && pgErr.Code == uniqueViolation {
+		return &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("category already exists"),
+		}
+	}
+	return err
+}
+
+// clampFutureTo caps an end date in the future to now when clamp is enabled, so that
+// date-filtered reads only report what happened so far. A nil end date is left as is,
+// the repositories already treating it as now.
+func clampFutureTo(to *time.Time, clamp bool) *time.Time {
+	if !clamp || to == nil {
+		return to
+	}
+	if now := time.Now(); to.After(now) {
+		return &now
+	}
+	return to
+}
+
+// checkNotFrozen rejects changes to a record created before the freeze date. A
+// nil freezeDate disables the check.
+func checkNotFrozen(createdAt *time.Time, freezeDate *time.Time) error {
+	if freezeDate == nil || createdAt == nil || !createdAt.Before(*freezeDate) {
+		return nil
+	}
+	return &domain.InvalidEntityError{
+		UnderlyingCause: fmt.Errorf("records created before %s are frozen", freezeDate.Format(time.DateOnly)),
+	}
+}
+
+// checkColor rejects colors that are not #RRGGBB hex strings. An empty color
+// means no color.
+func checkColor(color string) error {
+	if color != "" && !colorPattern.MatchString(color) {
+		return &domain.InvalidEntityError{
+			UnderlyingCause: errors.New("color must be a #RRGGBB hex string"),
+		}
+	}
+	return nil
+}
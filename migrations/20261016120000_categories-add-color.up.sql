@@ -0,0 +1 @@
+ALTER TABLE categories ADD COLUMN color VARCHAR(7);
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN default_category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE categories DROP CONSTRAINT categories_user_id_label_key;
+ALTER TABLE categories ADD CONSTRAINT categories_label_key UNIQUE (label);
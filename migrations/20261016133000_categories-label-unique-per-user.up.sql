@@ -0,0 +1,3 @@
+-- Category labels only need to be unique among the categories of a user
+ALTER TABLE categories DROP CONSTRAINT categories_label_key;
+ALTER TABLE categories ADD CONSTRAINT categories_user_id_label_key UNIQUE (user_id, label);
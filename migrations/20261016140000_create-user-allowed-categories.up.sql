@@ -0,0 +1,6 @@
+-- Categories a restricted user may log outcomes in, no row meaning unrestricted
+CREATE TABLE user_allowed_categories (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category_id INTEGER NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, category_id)
+);
@@ -0,0 +1,3 @@
+-- Uncategorized outcomes must be categorized or deleted beforehand
+ALTER TABLE outcomes ALTER COLUMN category_id SET NOT NULL;
+ALTER TABLE users DROP COLUMN require_category;
@@ -0,0 +1,3 @@
+-- Users not requiring a category may log uncategorized outcomes
+ALTER TABLE users ADD COLUMN require_category BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE outcomes ALTER COLUMN category_id DROP NOT NULL;
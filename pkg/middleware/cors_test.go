@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newPreflightRequest(origin string) *http.Request {
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/outcomes/", nil)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	return req
+}
+
+func TestCORS_PreflightSetsMaxAge(t *testing.T) {
+	cors := NewCORS([]string{"https://app.example.com"}, 600*time.Second)
+	handler := cors.CORSMiddleware(http.HandlerFunc(okHandler))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newPreflightRequest("https://app.example.com"))
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", w.Code)
+	}
+	if got := w.Header().Get("Access-Control-Max-Age"); got != "600" {
+		t.Errorf("expected Access-Control-Max-Age 600, got %q", got)
+	}
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("expected Access-Control-Allow-Origin to echo origin, got %q", got)
+	}
+}
+
+func TestCORS_PreflightWithoutMaxAge(t *testing.T) {
+	cors := NewCORS([]string{"*"}, 0)
+	handler := cors.CORSMiddleware(http.HandlerFunc(okHandler))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newPreflightRequest("https://app.example.com"))
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", w.Code)
+	}
+	if got := w.Header().Get("Access-Control-Max-Age"); got != "" {
+		t.Errorf("expected no Access-Control-Max-Age, got %q", got)
+	}
+}
+
+func TestCORS_DisallowedOriginIsPassedThrough(t *testing.T) {
+	cors := NewCORS([]string{"https://app.example.com"}, 600*time.Second)
+	handler := cors.CORSMiddleware(http.HandlerFunc(okHandler))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newPreflightRequest("https://evil.example.com"))
+
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("expected no Access-Control-Allow-Origin, got %q", got)
+	}
+	if got := w.Header().Get("Access-Control-Max-Age"); got != "" {
+		t.Errorf("expected no Access-Control-Max-Age, got %q", got)
+	}
+}
+
+func TestCORS_SimpleRequestReachesHandler(t *testing.T) {
+	cors := NewCORS([]string{"*"}, 600*time.Second)
+	handler := cors.CORSMiddleware(http.HandlerFunc(okHandler))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("expected Access-Control-Allow-Origin to echo origin, got %q", got)
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/kerhael/accounting/internal/handler/utils"
+)
+
+// loadShedRetryAfter is the delay suggested to shed clients before they retry.
+const loadShedRetryAfter = 1 * time.Second
+
+type LoadShedder struct {
+	slots       chan struct{}
+	exemptPaths []string
+}
+
+// NewLoadShedder builds a middleware serving at most maxConcurrent requests at
+// once, further requests being rejected with a 503 instead of slowing down
+// everyone. Requests to the exempt paths, such as health checks, are always
+// served. A maxConcurrent of zero disables load shedding.
+func NewLoadShedder(maxConcurrent int, exemptPaths ...string) *LoadShedder {
+	ls := &LoadShedder{exemptPaths: exemptPaths}
+	if maxConcurrent > 0 {
+		ls.slots = make(chan struct{}, maxConcurrent)
+	}
+	return ls
+}
+
+func (ls *LoadShedder) LoadSheddingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ls.slots == nil || slices.Contains(ls.exemptPaths, r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		select {
+		case ls.slots <- struct{}{}:
+			defer func() { <-ls.slots }()
+		default:
+			w.Header().Set("Retry-After", strconv.Itoa(int(loadShedRetryAfter.Seconds())))
+			utils.WriteJSONError(w, http.StatusServiceUnavailable, "server overloaded, retry later")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// blockingHandler holds its request until release is closed, signalling on
+// started once it is being served.
+func blockingHandler(started chan<- struct{}, release <-chan struct{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func TestLoadShedder_OverflowRejectedUntilRelease(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	shedder := NewLoadShedder(2, "/api/v1/health")
+	handler := shedder.LoadSheddingMiddleware(blockingHandler(started, release))
+
+	done := make(chan int, 2)
+	for range 2 {
+		go func() {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/outcomes/", nil))
+			done <- w.Code
+		}()
+		<-started
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/outcomes/", nil))
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "1" {
+		t.Errorf("expected Retry-After 1, got %q", got)
+	}
+
+	close(release)
+	for range 2 {
+		if code := <-done; code != http.StatusOK {
+			t.Errorf("expected 200 for the in-flight requests, got %d", code)
+		}
+	}
+
+	go func() { <-started }()
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/outcomes/", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200 after release, got %d", w.Code)
+	}
+}
+
+func TestLoadShedder_HealthCheckExempt(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	shedder := NewLoadShedder(1, "/api/v1/health")
+	handler := shedder.LoadSheddingMiddleware(blockingHandler(started, release))
+
+	done := make(chan int, 1)
+	go func() {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/outcomes/", nil))
+		done <- w.Code
+	}()
+	<-started
+
+	w := httptest.NewRecorder()
+	shedder.LoadSheddingMiddleware(http.HandlerFunc(okHandler)).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/health", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected health check to be served, got %d", w.Code)
+	}
+
+	close(release)
+	<-done
+}
+
+func TestLoadShedder_Disabled(t *testing.T) {
+	shedder := NewLoadShedder(0)
+	handler := shedder.LoadSheddingMiddleware(http.HandlerFunc(okHandler))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/outcomes/", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+}
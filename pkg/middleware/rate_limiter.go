@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"math"
 	"net"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -15,18 +17,31 @@ type client struct {
 	lastSeen time.Time
 }
 
+// KeyFunc returns the key requests are limited by, or "" to fall back to the
+// client IP.
+type KeyFunc func(r *http.Request) string
+
 type RateLimiter struct {
 	clients map[string]*client
 	mu      sync.Mutex
 	r       rate.Limit
 	burst   int
+	key     KeyFunc
 }
 
+// NewRateLimiter returns a rate limiter keyed by client IP.
 func NewRateLimiter(r rate.Limit, burst int) *RateLimiter {
+	return NewKeyedRateLimiter(r, burst, nil)
+}
+
+// NewKeyedRateLimiter returns a rate limiter keyed by key, such as the
+// authenticated user, requests without key being limited by client IP.
+func NewKeyedRateLimiter(r rate.Limit, burst int, key KeyFunc) *RateLimiter {
 	rl := &RateLimiter{
 		clients: make(map[string]*client),
 		r:       r,
 		burst:   burst,
+		key:     key,
 	}
 
 	// Cleanup goroutine
@@ -39,23 +54,24 @@ func (rl *RateLimiter) cleanup() {
 	for {
 		time.Sleep(time.Minute)
 		rl.mu.Lock()
-		for ip, c := range rl.clients {
-			if time.Since(c.lastSeen) > 3*time.Minute {
-				delete(rl.clients, ip)
+		for key, c := range rl.clients {
+			// Only refilled buckets are dropped, so slow limiters still apply
+			if time.Since(c.lastSeen) > 3*time.Minute && c.limiter.Tokens() >= float64(rl.burst) {
+				delete(rl.clients, key)
 			}
 		}
 		rl.mu.Unlock()
 	}
 }
 
-func (rl *RateLimiter) getLimiter(ip string) *rate.Limiter {
+func (rl *RateLimiter) getLimiter(key string) *rate.Limiter {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
-	c, exists := rl.clients[ip]
+	c, exists := rl.clients[key]
 	if !exists {
 		limiter := rate.NewLimiter(rl.r, rl.burst)
-		rl.clients[ip] = &client{
+		rl.clients[key] = &client{
 			limiter:  limiter,
 			lastSeen: time.Now(),
 		}
@@ -69,10 +85,19 @@ func (rl *RateLimiter) getLimiter(ip string) *rate.Limiter {
 func (rl *RateLimiter) RateLimitMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
-		ip, _, _ := net.SplitHostPort(r.RemoteAddr)
-		limiter := rl.getLimiter(ip)
+		key := ""
+		if rl.key != nil {
+			key = rl.key(r)
+		}
+		if key == "" {
+			key, _, _ = net.SplitHostPort(r.RemoteAddr)
+		}
+		limiter := rl.getLimiter(key)
 
-		if !limiter.Allow() {
+		reservation := limiter.Reserve()
+		if delay := reservation.Delay(); delay > 0 {
+			reservation.Cancel()
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
 			utils.WriteJSONError(w, http.StatusTooManyRequests, "too many requests")
 			return
 		}
@@ -144,3 +144,59 @@ func TestRateLimiter_DoesNotCallNextHandlerWhenBlocked(t *testing.T) {
 		t.Errorf("expected 429, got %d", w.Code)
 	}
 }
+
+func TestRateLimiter_SetsRetryAfter(t *testing.T) {
+	// One request every 10 seconds
+	rl := NewRateLimiter(rate.Limit(0.1), 1)
+	handler := rl.RateLimitMiddleware(http.HandlerFunc(okHandler))
+
+	req1 := httptest.NewRequest(http.MethodGet, "/", nil)
+	req1.RemoteAddr = "3.3.3.3:3000"
+	handler.ServeHTTP(httptest.NewRecorder(), req1)
+
+	req2 := httptest.NewRequest(http.MethodGet, "/", nil)
+	req2.RemoteAddr = "3.3.3.3:3000"
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req2)
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "10" {
+		t.Errorf("expected Retry-After 10, got %q", got)
+	}
+}
+
+func TestKeyedRateLimiter_LimitsByKey(t *testing.T) {
+	rl := NewKeyedRateLimiter(rate.Limit(0.001), 1, func(r *http.Request) string {
+		return r.Header.Get("X-User")
+	})
+	handler := rl.RateLimitMiddleware(http.HandlerFunc(okHandler))
+
+	serve := func(user string, remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		if user != "" {
+			req.Header.Set("X-User", user)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if code := serve("1", "1.1.1.1:1000"); code != http.StatusOK {
+		t.Errorf("first request of user 1: expected 200, got %d", code)
+	}
+	// Same user from another IP shares the bucket
+	if code := serve("1", "2.2.2.2:2000"); code != http.StatusTooManyRequests {
+		t.Errorf("second request of user 1: expected 429, got %d", code)
+	}
+	// Another user from the same IP has its own bucket
+	if code := serve("2", "1.1.1.1:1000"); code != http.StatusOK {
+		t.Errorf("first request of user 2: expected 200, got %d", code)
+	}
+	// Requests without key fall back to the client IP
+	if code := serve("", "1.1.1.1:1000"); code != http.StatusOK {
+		t.Errorf("first request without key: expected 200, got %d", code)
+	}
+}
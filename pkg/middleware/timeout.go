@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/kerhael/accounting/internal/handler/utils"
+)
+
+type Timeout struct {
+	timeout time.Duration
+}
+
+// NewTimeout builds a middleware giving each request a deadline of the given
+// duration. The request context is cancelled once it is reached, aborting the
+// database queries still running for it, and the server error the handler then
+// answers is replaced with a 504.
+func NewTimeout(timeout time.Duration) *Timeout {
+	return &Timeout{timeout: timeout}
+}
+
+func (t *Timeout) TimeoutMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), t.timeout)
+		defer cancel()
+
+		next.ServeHTTP(&timeoutWriter{ResponseWriter: w, ctx: ctx}, r.WithContext(ctx))
+	})
+}
+
+// timeoutWriter turns the server errors written after the deadline, which carry
+// the raw error of the aborted query, into a 504 Gateway Timeout.
+type timeoutWriter struct {
+	http.ResponseWriter
+	ctx      context.Context
+	timedOut bool
+}
+
+func (tw *timeoutWriter) WriteHeader(status int) {
+	if status == http.StatusInternalServerError && errors.Is(tw.ctx.Err(), context.DeadlineExceeded) {
+		tw.timedOut = true
+		utils.WriteJSONError(tw.ResponseWriter, http.StatusGatewayTimeout, "request timed out")
+		return
+	}
+	tw.ResponseWriter.WriteHeader(status)
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	if tw.timedOut {
+		// Drop the handler's own error body
+		return len(b), nil
+	}
+	return tw.ResponseWriter.Write(b)
+}
+
+func (tw *timeoutWriter) Unwrap() http.ResponseWriter {
+	return tw.ResponseWriter
+}
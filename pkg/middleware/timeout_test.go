@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kerhael/accounting/internal/handler/utils"
+)
+
+// slowHandler stands for a long-running report: it answers 200 after 50ms, or
+// 503 if its context is cancelled first.
+func slowHandler(w http.ResponseWriter, r *http.Request) {
+	select {
+	case <-time.After(50 * time.Millisecond):
+		w.WriteHeader(http.StatusOK)
+	case <-r.Context().Done():
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
+
+func TestTimeout_SlowReportWithinReportTimeout(t *testing.T) {
+	reportTimeout := NewTimeout(5 * time.Second)
+	handler := reportTimeout.TimeoutMiddleware(http.HandlerFunc(slowHandler))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/outcomes/series-total", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+}
+
+func TestTimeout_SlowReportCancelledUnderCRUDTimeout(t *testing.T) {
+	crudTimeout := NewTimeout(5 * time.Millisecond)
+	handler := crudTimeout.TimeoutMiddleware(http.HandlerFunc(slowHandler))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/outcomes/series-total", nil))
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected the request context to be cancelled, got %d", w.Code)
+	}
+}
+
+func TestTimeout_SetsDeadline(t *testing.T) {
+	timeout := NewTimeout(time.Minute)
+
+	var deadline time.Time
+	handler := timeout.TimeoutMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline, _ = r.Context().Deadline()
+	}))
+
+	before := time.Now()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if deadline.Before(before.Add(time.Minute)) || deadline.After(time.Now().Add(time.Minute)) {
+		t.Errorf("expected a deadline one minute from now, got %s", deadline)
+	}
+}
+
+func TestTimeout_ServerErrorAfterDeadlineBecomesGatewayTimeout(t *testing.T) {
+	timeout := NewTimeout(5 * time.Millisecond)
+	handler := timeout.TimeoutMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		utils.WriteJSONError(w, http.StatusInternalServerError, "timeout: context deadline exceeded")
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/outcomes/series-total", nil))
+
+	if w.Code != http.StatusGatewayTimeout {
+		t.Errorf("expected 504, got %d", w.Code)
+	}
+	if body := w.Body.String(); body != "{\"message\":\"request timed out\"}\n" {
+		t.Errorf("expected a timeout message without the query error, got %q", body)
+	}
+}
+
+func TestTimeout_ServerErrorBeforeDeadlineIsKept(t *testing.T) {
+	timeout := NewTimeout(time.Minute)
+	handler := timeout.TimeoutMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		utils.WriteJSONError(w, http.StatusInternalServerError, "boom")
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", w.Code)
+	}
+}
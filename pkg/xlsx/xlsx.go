@@ -0,0 +1,132 @@
+// Package xlsx writes single-sheet Excel (Office Open XML) workbooks with
+// excelize, with just enough formatting for exports: a bold header row, money
+// and date cells.
+package xlsx
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ContentType is the media type of XLSX files.
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// Money is an amount in cents, written as a number with two decimals.
+type Money int
+
+// Number formats of the money and date cells.
+const (
+	moneyFormat    = 4 // #,##0.00, built into Excel
+	dateTimeFormat = "yyyy-mm-dd hh:mm"
+)
+
+// Sheet is a worksheet made of a header row followed by data rows. Cells may be
+// strings, ints, float64s, Money or time.Time values, the latter written with
+// their wall clock time.
+type Sheet struct {
+	Name   string
+	Header []string
+	Rows   [][]any
+}
+
+// Workbook is a workbook holding a laid out sheet, ready to be written. Rows
+// are streamed, excelize moving them to a temporary file past a memory
+// threshold, so it must be closed once written.
+type Workbook struct {
+	file *excelize.File
+}
+
+// New lays out the sheet in a new workbook.
+func New(sheet Sheet) (*Workbook, error) {
+	wb := &Workbook{file: excelize.NewFile()}
+	if err := wb.layout(sheet); err != nil {
+		wb.Close()
+		return nil, err
+	}
+	return wb, nil
+}
+
+// Write lays out the sheet in a new workbook and writes it to w.
+func Write(w io.Writer, sheet Sheet) error {
+	wb, err := New(sheet)
+	if err != nil {
+		return err
+	}
+	defer wb.Close()
+
+	_, err = wb.WriteTo(w)
+	return err
+}
+
+// WriteTo writes the workbook to w.
+func (wb *Workbook) WriteTo(w io.Writer) (int64, error) {
+	return wb.file.WriteTo(w)
+}
+
+// Close removes the temporary files of the workbook.
+func (wb *Workbook) Close() error {
+	return wb.file.Close()
+}
+
+func (wb *Workbook) layout(sheet Sheet) error {
+	f := wb.file
+	if err := f.SetSheetName(f.GetSheetName(0), sheet.Name); err != nil {
+		return err
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return err
+	}
+	moneyStyle, err := f.NewStyle(&excelize.Style{NumFmt: moneyFormat})
+	if err != nil {
+		return err
+	}
+	format := dateTimeFormat
+	dateTimeStyle, err := f.NewStyle(&excelize.Style{CustomNumFmt: &format})
+	if err != nil {
+		return err
+	}
+
+	sw, err := f.NewStreamWriter(sheet.Name)
+	if err != nil {
+		return err
+	}
+
+	header := make([]any, len(sheet.Header))
+	for i, h := range sheet.Header {
+		header[i] = excelize.Cell{StyleID: headerStyle, Value: h}
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		return err
+	}
+
+	for i, values := range sheet.Rows {
+		row := make([]any, len(values))
+		for j, value := range values {
+			switch v := value.(type) {
+			case string, int, float64:
+				row[j] = v
+			case Money:
+				row[j] = excelize.Cell{StyleID: moneyStyle, Value: float64(v) / 100}
+			case time.Time:
+				row[j] = excelize.Cell{StyleID: dateTimeStyle, Value: v}
+			default:
+				return fmt.Errorf("unsupported cell type %T", value)
+			}
+		}
+
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+		if err := sw.SetRow(cell, row); err != nil {
+			return err
+		}
+	}
+
+	return sw.Flush()
+}
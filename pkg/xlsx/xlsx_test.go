@@ -0,0 +1,75 @@
+package xlsx
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, Sheet{
+		Name:   "Outcomes",
+		Header: []string{"Date", "Name", "Amount"},
+		Rows: [][]any{
+			{time.Date(2026, 1, 2, 12, 0, 0, 0, time.FixedZone("CET", 3600)), "Fish & Chips", Money(1999)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("expected a valid workbook, got %v", err)
+	}
+	defer f.Close()
+
+	if sheets := f.GetSheetList(); !slices.Equal(sheets, []string{"Outcomes"}) {
+		t.Errorf("expected an Outcomes sheet, got %v", sheets)
+	}
+
+	rows, err := f.GetRows("Outcomes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"Date", "Name", "Amount"},
+		// The wall clock time is kept, whatever the location
+		{"2026-01-02 12:00", "Fish & Chips", "19.99"},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("expected %v, got %v", want, rows)
+	}
+	for i := range want {
+		if !slices.Equal(rows[i], want[i]) {
+			t.Errorf("expected row %d to be %v, got %v", i+1, want[i], rows[i])
+		}
+	}
+
+	raw, err := f.GetCellValue("Outcomes", "A2", excelize.Options{RawCellValue: true})
+	if err != nil || raw != "46024.5" {
+		t.Errorf("expected a date serial number, got %q (%v)", raw, err)
+	}
+
+	style, err := f.GetCellStyle("Outcomes", "A1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	header, err := f.GetStyle(style)
+	if err != nil || header.Font == nil || !header.Font.Bold {
+		t.Errorf("expected a bold header, got %+v (%v)", header, err)
+	}
+}
+
+func TestWrite_UnsupportedCell(t *testing.T) {
+	err := Write(io.Discard, Sheet{Name: "Sheet", Rows: [][]any{{true}}})
+
+	if err == nil || err.Error() != "unsupported cell type bool" {
+		t.Errorf("expected unsupported cell type error, got %v", err)
+	}
+}